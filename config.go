@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// config is the contents of a slice.yaml or slice.json file. Top-level
+// settings apply to every type, and may be overridden per type.
+//
+//	package: godash
+//	dir: go-dash-slice
+//	out: slices.go
+//	types:
+//	  - type: string
+//	  - type: "*User"
+//	    imports: [github.com/me/users]
+//	    methods: [Filter, Map, MapTo]
+//	    map-to: [string]
+//	  - type: Order
+//	    exclude: [Uniq, Contains]
+//	    generic: true
+type config struct {
+	Package  string       `json:"package" yaml:"package"`
+	BuildTag string       `json:"build-tag" yaml:"build-tag"`
+	Dir      string       `json:"dir" yaml:"dir"`
+	Out      string       `json:"out" yaml:"out"`
+	Imports  []string     `json:"imports" yaml:"imports"`
+	Methods  []string     `json:"methods" yaml:"methods"`
+	Exclude  []string     `json:"exclude" yaml:"exclude"`
+	Generic  *bool        `json:"generic" yaml:"generic"`
+	Iter     *bool        `json:"iter" yaml:"iter"`
+	MapTo    []string     `json:"map-to" yaml:"map-to"`
+	KeyTypes []string     `json:"key-types" yaml:"key-types"`
+	ZipWith  []string     `json:"zip-with" yaml:"zip-with"`
+	Types    []configType `json:"types" yaml:"types"`
+}
+
+// configType is a single entry in config.Types.
+type configType struct {
+	Type     string   `json:"type" yaml:"type"`
+	Package  string   `json:"package" yaml:"package"`
+	BuildTag string   `json:"build-tag" yaml:"build-tag"`
+	Dir      string   `json:"dir" yaml:"dir"`
+	Out      string   `json:"out" yaml:"out"`
+	Imports  []string `json:"imports" yaml:"imports"`
+	Methods  []string `json:"methods" yaml:"methods"`
+	Exclude  []string `json:"exclude" yaml:"exclude"`
+	Generic  *bool    `json:"generic" yaml:"generic"`
+	Iter     *bool    `json:"iter" yaml:"iter"`
+	MapTo    []string `json:"map-to" yaml:"map-to"`
+	KeyTypes []string `json:"key-types" yaml:"key-types"`
+	ZipWith  []string `json:"zip-with" yaml:"zip-with"`
+}
+
+// loadConfig reads a config file, as YAML or JSON depending on its extension.
+func loadConfig(filename string) (*config, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &config{}
+
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(content))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(c)
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(content, c)
+	default:
+		return nil, fmt.Errorf("%s: unknown config format, expected .yaml, .yml or .json", filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err)
+	}
+
+	return c, nil
+}
+
+// specs returns a typeSpec for each type in the config. Settings missing from
+// the config are taken from defaults, and relative directories are resolved
+// against baseDir (normally the directory holding the config file).
+func (c *config) specs(defaults typeSpec, baseDir string) ([]typeSpec, error) {
+	top := mergeSpec(defaults, typeSpec{
+		Package:  c.Package,
+		BuildTag: c.BuildTag,
+		Dir:      resolveDir(baseDir, c.Dir),
+		Out:      c.Out,
+		Imports:  c.Imports,
+		Methods:  c.Methods,
+		Exclude:  c.Exclude,
+		MapTo:    c.MapTo,
+		KeyTypes: c.KeyTypes,
+		ZipWith:  c.ZipWith,
+	})
+	if c.Generic != nil {
+		top.Generic = *c.Generic
+	}
+	if c.Iter != nil {
+		top.Iter = *c.Iter
+	}
+
+	var specs []typeSpec
+	for i, t := range c.Types {
+		if t.Type == "" {
+			return nil, fmt.Errorf("types[%d]: missing type", i)
+		}
+
+		spec := mergeSpec(top, typeSpec{
+			Type:     t.Type,
+			Package:  t.Package,
+			BuildTag: t.BuildTag,
+			Dir:      resolveDir(baseDir, t.Dir),
+			Out:      t.Out,
+			Imports:  t.Imports,
+			Methods:  t.Methods,
+			Exclude:  t.Exclude,
+			MapTo:    t.MapTo,
+			KeyTypes: t.KeyTypes,
+			ZipWith:  t.ZipWith,
+		})
+		if t.Generic != nil {
+			spec.Generic = *t.Generic
+		}
+		if t.Iter != nil {
+			spec.Iter = *t.Iter
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+// mergeSpec returns base with any settings given in override replacing it.
+// Imports are added to, rather than replaced.
+func mergeSpec(base typeSpec, override typeSpec) typeSpec {
+	res := base
+
+	if override.Type != "" {
+		res.Type = override.Type
+	}
+	if override.Package != "" {
+		res.Package = override.Package
+	}
+	if override.BuildTag != "" {
+		res.BuildTag = override.BuildTag
+	}
+	if override.Dir != "" {
+		res.Dir = override.Dir
+	}
+	if override.Out != "" {
+		res.Out = override.Out
+	}
+	if len(override.Methods) > 0 {
+		res.Methods = override.Methods
+	}
+	if len(override.Exclude) > 0 {
+		res.Exclude = override.Exclude
+	}
+	if len(override.MapTo) > 0 {
+		res.MapTo = override.MapTo
+	}
+	if len(override.KeyTypes) > 0 {
+		res.KeyTypes = override.KeyTypes
+	}
+	if len(override.ZipWith) > 0 {
+		res.ZipWith = override.ZipWith
+	}
+	// false is indistinguishable from unset, so these can only be turned on
+	if override.Generic {
+		res.Generic = true
+	}
+	if override.Iter {
+		res.Iter = true
+	}
+
+	res.Imports = append([]string{}, base.Imports...)
+	for _, imp := range override.Imports {
+		if !containsString(res.Imports, imp) {
+			res.Imports = append(res.Imports, imp)
+		}
+	}
+
+	return res
+}
+
+func resolveDir(baseDir string, dir string) string {
+	if dir == "" || filepath.IsAbs(dir) {
+		return dir
+	}
+	return filepath.Join(baseDir, dir)
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfig(t *testing.T, name string, content string) string {
+	dir, err := ioutil.TempDir("", "slice-config")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	filename := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(filename, []byte(content), 0644))
+	return filename
+}
+
+func TestConfigSpecs(t *testing.T) {
+	var tests = []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			"should load yaml config",
+			"slice.yaml",
+			`
+package: models
+dir: gen
+imports: [example.com/common]
+methods: [Filter, Map]
+types:
+  - type: string
+  - type: "*User"
+    imports: [example.com/users]
+    out: users.go
+    methods: [Uniq]
+    iter: true
+`,
+		},
+		{
+			"should load json config",
+			"slice.json",
+			`{
+  "package": "models",
+  "dir": "gen",
+  "imports": ["example.com/common"],
+  "methods": ["Filter", "Map"],
+  "types": [
+    {"type": "string"},
+    {"type": "*User", "imports": ["example.com/users"], "out": "users.go", "methods": ["Uniq"], "iter": true}
+  ]
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filename := writeConfig(t, test.file, test.content)
+			baseDir := filepath.Dir(filename)
+
+			c, err := loadConfig(filename)
+			require.NoError(t, err)
+
+			specs, err := c.specs(typeSpec{Package: "godash", BuildTag: "gen", Dir: "go-dash-slice"}, baseDir)
+			require.NoError(t, err)
+
+			require.Equal(t, []typeSpec{
+				{
+					Type:     "string",
+					Imports:  []string{"example.com/common"},
+					Package:  "models",
+					BuildTag: "gen",
+					Dir:      filepath.Join(baseDir, "gen"),
+					Methods:  []string{"Filter", "Map"},
+				},
+				{
+					Type:     "*User",
+					Imports:  []string{"example.com/common", "example.com/users"},
+					Package:  "models",
+					BuildTag: "gen",
+					Dir:      filepath.Join(baseDir, "gen"),
+					Out:      "users.go",
+					Methods:  []string{"Uniq"},
+					Iter:     true,
+				},
+			}, specs)
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{"should reject unknown yaml fields", "slice.yaml", "types:\n  - tpye: string\n"},
+		{"should reject unknown json fields", "slice.json", `{"types": [{"tpye": "string"}]}`},
+		{"should reject unknown formats", "slice.toml", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := loadConfig(writeConfig(t, test.file, test.content))
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("should reject types without a name", func(t *testing.T) {
+		c, err := loadConfig(writeConfig(t, "slice.yaml", "types:\n  - package: p\n"))
+		require.NoError(t, err)
+
+		_, err = c.specs(typeSpec{}, ".")
+		require.Error(t, err)
+	})
+}
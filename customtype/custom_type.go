@@ -3,3 +3,26 @@ package customtype
 type CustomType struct {
 	Name string
 }
+
+// TaggedType is not comparable, so slices of it rely on its Equal method.
+type TaggedType struct {
+	Name string
+	Tags []string
+}
+
+func (t TaggedType) Equal(other TaggedType) bool {
+	return t.Name == other.Name
+}
+
+// Less orders CustomTypes by Name, so slices of them can be sorted.
+func (t CustomType) Less(other CustomType) bool {
+	return t.Name < other.Name
+}
+
+// Row and Grid are nested slices, for FlattenDeep.
+type Row []int
+
+type Grid []Row
+
+// Cents is a named integer type, for numeric aggregates.
+type Cents int64
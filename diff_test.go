@@ -0,0 +1,124 @@
+package main
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	var tests = []struct {
+		name   string
+		a      string
+		b      string
+		output string
+	}{
+		{
+			"should return nothing for equal content",
+			"a\nb\n",
+			"a\nb\n",
+			"",
+		},
+		{
+			"should show a changed line with context",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			"1\n2\n3\n4\nfive\n6\n7\n8\n9\n",
+			"--- a\n+++ b\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			"should show separate hunks for distant changes",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			"one\n2\n3\n4\n5\n6\n7\n8\n9\nten\n",
+			"--- a\n+++ b\n@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+ten\n",
+		},
+		{
+			"should show added lines",
+			"",
+			"a\nb\n",
+			"--- a\n+++ b\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			"should show removed lines",
+			"a\nb\nc\n",
+			"a\nc\n",
+			"--- a\n+++ b\n@@ -1,3 +1,2 @@\n a\n-b\n c\n",
+		},
+		{
+			"should show a missing newline at the end",
+			"a\nb\n",
+			"a\nb",
+			"--- a\n+++ b\n@@ -1,2 +1,2 @@\n a\n-b\n+b\n\\ No newline at end of file\n",
+		},
+		{
+			"should show an added newline at the end",
+			"a",
+			"a\n",
+			"--- a\n+++ b\n@@ -1 +1 @@\n-a\n\\ No newline at end of file\n+a\n",
+		},
+		{
+			"should show changed line endings",
+			"a\r\nb\n",
+			"a\nb\n",
+			"--- a\n+++ b\n@@ -1,2 +1,2 @@\n-a\r\n+a\n b\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := unifiedDiff("a", "b", []byte(test.a), []byte(test.b))
+
+			require.Equal(t, test.output, got)
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 500; i++ {
+		a = append(a, strconv.Itoa(i)+"\n")
+		if i%7 != 0 {
+			b = append(b, strconv.Itoa(i)+"\n")
+		}
+		if i%11 == 0 {
+			b = append(b, "new"+strconv.Itoa(i)+"\n")
+		}
+	}
+
+	ops := diffLines(a, b)
+
+	// the edits must turn a into b, keeping every line they can
+	var gotA, gotB []string
+	kept := 0
+	for _, op := range ops {
+		if op.kind != '+' {
+			gotA = append(gotA, op.line)
+		}
+		if op.kind != '-' {
+			gotB = append(gotB, op.line)
+		}
+		if op.kind == ' ' {
+			kept++
+		}
+	}
+	require.Equal(t, a, gotA)
+	require.Equal(t, b, gotB)
+	require.Equal(t, 500-72, kept)
+}
+
+func TestDiffLinesMemory(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 3000; i++ {
+		a = append(a, "a"+strconv.Itoa(i))
+		b = append(b, "b"+strconv.Itoa(i))
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	ops := diffLines(a, b)
+	runtime.ReadMemStats(&after)
+
+	require.Len(t, ops, 6000)
+	require.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(32<<20))
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MARKER annotates a type declaration to have a slice chain generated for it.
+// It may be followed by options, e.g.
+//
+//	//slice:generate methods=Filter,Map
+//	type User struct { ... }
+const MARKER = "//slice:generate"
+
+// DISCOVER_OUT is the file discovered types are generated into, unless -out
+// is given.
+const DISCOVER_OUT = "slice_generated.go"
+
+// discoveredPackage holds the types found by discoverTypes.
+type discoveredPackage struct {
+	Name  string
+	Specs []typeSpec
+}
+
+// discoverTypes parses the (non-test) Go files in dir, returning a typeSpec
+// for each type declaration annotated with MARKER.
+func discoverTypes(dir string) (*discoveredPackage, error) {
+	fset := token.NewFileSet()
+
+	notTest := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+
+	pkgs, err := parser.ParseDir(fset, dir, notTest, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) > 1 {
+		return nil, fmt.Errorf("%s: found more than one package", dir)
+	}
+
+	res := &discoveredPackage{}
+
+	for name, pkg := range pkgs {
+		res.Name = name
+
+		filenames := make([]string, 0, len(pkg.Files))
+		for filename := range pkg.Files {
+			filenames = append(filenames, filename)
+		}
+		sort.Strings(filenames)
+
+		for _, filename := range filenames {
+			for _, decl := range pkg.Files[filename].Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+
+					doc := ts.Doc
+					if doc == nil && len(gen.Specs) == 1 {
+						doc = gen.Doc
+					}
+
+					options, found := findMarker(doc)
+					if !found {
+						continue
+					}
+
+					s, err := parseMarker(ts.Name.Name, options)
+					if err != nil {
+						return nil, fmt.Errorf("%s: %s", fset.Position(ts.Pos()), err)
+					}
+					res.Specs = append(res.Specs, s)
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// findMarker returns the options following MARKER in doc, if present.
+func findMarker(doc *ast.CommentGroup) (string, bool) {
+	if doc == nil {
+		return "", false
+	}
+
+	for _, c := range doc.List {
+		if c.Text == MARKER {
+			return "", true
+		}
+		if strings.HasPrefix(c.Text, MARKER+" ") {
+			return strings.TrimSpace(c.Text[len(MARKER):]), true
+		}
+	}
+	return "", false
+}
+
+// parseMarker builds a typeSpec for typeName from space-separated key=value
+// marker options.
+func parseMarker(typeName string, options string) (typeSpec, error) {
+	spec := typeSpec{Type: typeName}
+
+	for _, option := range strings.Fields(options) {
+		i := strings.Index(option, "=")
+		if i < 0 {
+			return spec, fmt.Errorf("%s: option %s: expected key=value", MARKER, option)
+		}
+
+		key, value := option[:i], option[i+1:]
+		switch key {
+		case "methods":
+			spec.Methods = strings.Split(value, ",")
+		case "exclude":
+			spec.Exclude = strings.Split(value, ",")
+		case "map-to":
+			spec.MapTo = splitList(value)
+		case "key-types":
+			spec.KeyTypes = splitList(value)
+		case "zip-with":
+			spec.ZipWith = splitList(value)
+		case "generic":
+			generic, err := strconv.ParseBool(value)
+			if err != nil {
+				return spec, fmt.Errorf("%s: option %s: %s", MARKER, option, err)
+			}
+			spec.Generic = generic
+		case "iter":
+			iter, err := strconv.ParseBool(value)
+			if err != nil {
+				return spec, fmt.Errorf("%s: option %s: %s", MARKER, option, err)
+			}
+			spec.Iter = iter
+		default:
+			return spec, fmt.Errorf("%s: unknown option %s", MARKER, key)
+		}
+	}
+
+	return spec, nil
+}
+
+// splitList splits a comma-separated marker value as stringList does,
+// dropping empty entries.
+func splitList(value string) []string {
+	var l stringList
+	l.Set(value)
+	return l
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writePackage(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "slice-discover")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	for name, content := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestDiscoverTypes(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"user.go": `package models
+
+//slice:generate key-types=,int
+type User struct {
+	Name string
+}
+
+// Order is not annotated.
+type Order struct{}
+
+type (
+	// Tag is a label.
+	//slice:generate methods=Filter,Map map-to=string,
+	Tag string
+
+	Other int
+)
+
+//slice:generate exclude=Uniq,Contains iter=true zip-with=,int,,
+type Record struct {
+	Fields map[string]string
+}
+`,
+		"user_test.go": `package models
+
+//slice:generate
+type testOnly struct{}
+`,
+	})
+
+	pkg, err := discoverTypes(dir)
+	require.NoError(t, err)
+
+	require.Equal(t, "models", pkg.Name)
+	require.Equal(t, []typeSpec{
+		{Type: "User", KeyTypes: []string{"int"}},
+		{Type: "Tag", Methods: []string{"Filter", "Map"}, MapTo: []string{"string"}},
+		{Type: "Record", Exclude: []string{"Uniq", "Contains"}, Iter: true, ZipWith: []string{"int"}},
+	}, pkg.Specs)
+}
+
+func TestDiscoverTypesBadOptions(t *testing.T) {
+	var tests = []struct {
+		name   string
+		marker string
+	}{
+		{"should reject options without a value", "//slice:generate methods"},
+		{"should reject unknown options", "//slice:generate colour=blue"},
+		{"should reject options which are not booleans", "//slice:generate iter=yes"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := writePackage(t, map[string]string{
+				"a.go": "package a\n\n" + test.marker + "\ntype A int\n",
+			})
+
+			_, err := discoverTypes(dir)
+			require.Error(t, err)
+		})
+	}
+}
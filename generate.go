@@ -1,31 +1,46 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
+	"go/types"
+	"io"
+	"io/ioutil"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"text/template"
 )
 
-const TEMPLATE = `// This code is generated by https://github.com/jtyers/slice
+const HEADER_TEMPLATE = `// This code is generated by https://github.com/jtyers/slice
 // DO NOT EDIT!
 
-{{ if .BuildTag }}
-// +build {{ .BuildTag }}
-{{ end }}
+{{ if or .BuildTag .Generic .Companion }}
+{{ if .BuildTag }}// +build {{ .BuildTag }}
+{{ end }}{{ if .Companion }}// +build go1.23
+{{ else if .Generic }}// +build go1.18
+{{ end }}{{ end }}
 
 package {{ .Package }}
-{{ if .Import }}
+{{ if or .PlainImports .Imports }}
 
 import (
-  . "{{ .Import }}"
-)
+{{ range .PlainImports }}  "{{ . }}"
+{{ end }}{{ range .Imports }}  . "{{ . }}"
+{{ end }})
 {{ end }}
+`
+
+// GENERIC_IMPORT is the package generated code wraps when -generic is given.
+const GENERIC_IMPORT = "github.com/jtyers/slice/generic"
+
+const TEMPLATE = `{{ define "chain" -}}
 type chain{{ .TypeNameCapitalised }} struct {
   isPtr bool
 	value []{{ .TypeLiteral }}
+	err error
 }
 
 func {{ .NewFuncName }}(slice []{{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
@@ -39,7 +54,22 @@ func (c *chain{{ .TypeNameCapitalised }}) Value() []{{ .TypeLiteral }} {
 	return c.value
 }
 
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chain{{ .TypeNameCapitalised }}) Result() ([]{{ .TypeLiteral }}, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+{{ end }}
+
+{{ define "Concat" -}}
 func Concat{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, slice2 []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	{{ if .Generic }}return generic.Concat(slice, slice2)
+	{{- else -}}
 	res = make([]{{ .TypeLiteral }}, 0, len(slice) + len(slice2))
 	for _, entry := range slice {
 		res = append(res, entry)
@@ -48,61 +78,95 @@ func Concat{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, slice2 []{{ .T
 		res = append(res, entry)
 	}
 	return
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) Concat(slice2 []{{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
-	return &chain{{ .TypeNameCapitalised }}{value: Concat{{ .TypeNameCapitalised }}(c.value, slice2)}
+	return &chain{{ .TypeNameCapitalised }}{value: Concat{{ .TypeNameCapitalised }}(c.value, slice2), err: c.err}
 }
 
+{{ end }}
+
+{{ define "Contains" -}}
 func Contains{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, item {{ .TypeLiteral }}) (res bool) {
+	{{ if .Generic }}{{ if .EqualMethod }}return generic.ContainsFunc(slice, item, func(a, b {{ .TypeLiteral }}) bool { return {{ if .IsPtr }}a == b || a.Equal(*b){{ else }}a.Equal(b){{ end }} })
+	{{- else if .IsPtr }}return generic.ContainsFunc(slice, item, func(a, b {{ .TypeLiteral }}) bool { return a == b || *a == *b })
+	{{- else }}return generic.Contains(slice, item){{ end }}
+	{{- else -}}
 	for _, val := range slice {
-		if val == item {
+		{{ if .EqualMethod }}if {{ if .IsPtr }}val == item || val.Equal(*item){{ else }}val.Equal(item){{ end }} {
+			return true
+		}{{ else }}if val == item {
 			return true
 		}
 		{{ if .IsPtr}}if *val == *item {
 			return true
-		}{{ end }}
+		}{{ end }}{{ end }}
 	}
 	return false
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) Contains(item {{ .TypeLiteral }}) bool {
 	return Contains{{ .TypeNameCapitalised }}(c.value, item)
 }
 
+{{ end }}
+
+{{ define "Drop" -}}
 func Drop{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, n int) (res []{{ .TypeLiteral }}) {
+	{{ if .Generic }}return generic.Drop(slice, n)
+	{{- else -}}
 	l := len(slice) - n
 	if l < 0 {
 		l = 0
 	}
+	if l > len(slice) {
+		l = len(slice)
+	}
 	res = make([]{{ .TypeLiteral }}, 0, l)
 	for _, entry := range slice[len(slice) - l:] {
 		res = append(res, entry)
 	}
 	return
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) Drop(n int) *chain{{ .TypeNameCapitalised }} {
-	return &chain{{ .TypeNameCapitalised }}{value: Drop{{ .TypeNameCapitalised }}(c.value, n)}
+	return &chain{{ .TypeNameCapitalised }}{value: Drop{{ .TypeNameCapitalised }}(c.value, n), err: c.err}
 }
 
+{{ end }}
+
+{{ define "DropRight" -}}
 func DropRight{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, n int) (res []{{ .TypeLiteral }}) {
+	{{ if .Generic }}return generic.DropRight(slice, n)
+	{{- else -}}
 	l := len(slice) - n
 	if l < 0 {
 		l = 0
 	}
+	if l > len(slice) {
+		l = len(slice)
+	}
 	res = make([]{{ .TypeLiteral }}, 0, l)
 	for _, entry := range slice[:l] {
 		res = append(res, entry)
 	}
 	return
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) DropRight(n int) *chain{{ .TypeNameCapitalised }} {
-	return &chain{{ .TypeNameCapitalised }}{value: DropRight{{ .TypeNameCapitalised }}(c.value, n)}
+	return &chain{{ .TypeNameCapitalised }}{value: DropRight{{ .TypeNameCapitalised }}(c.value, n), err: c.err}
 }
 
+{{ end }}
+
+{{ define "Filter" -}}
 func Filter{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }},int)bool) (res []{{ .TypeLiteral }}) {
+	{{ if .Generic }}return generic.Filter(slice, fn)
+	{{- else -}}
 	res = make([]{{ .TypeLiteral }}, 0, len(slice))
 	for index, entry := range slice {
 		if fn(entry, index) {
@@ -110,75 +174,122 @@ func Filter{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .Ty
 		}
 	}
 	return
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) Filter(fn func({{ .TypeLiteral }},int)bool) *chain{{ .TypeNameCapitalised }} {
-	return &chain{{ .TypeNameCapitalised }}{value: Filter{{ .TypeNameCapitalised }}(c.value, fn)}
+	return &chain{{ .TypeNameCapitalised }}{value: Filter{{ .TypeNameCapitalised }}(c.value, fn), err: c.err}
 }
 
+{{ end }}
+
+{{ define "First" -}}
 func First{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res {{ .TypeLiteral }}) {
+	{{ if .Generic }}return generic.First(slice)
+	{{- else -}}
 	if len(slice) == 0 {
 		return
 	}
 	res = slice[0]
 	return
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) First() *chain{{ .TypeNameCapitalised }} {
-	return &chain{{ .TypeNameCapitalised }}{value: []{{ .TypeLiteral }}{First{{ .TypeNameCapitalised }}(c.value)}}
+	return &chain{{ .TypeNameCapitalised }}{value: []{{ .TypeLiteral }}{First{{ .TypeNameCapitalised }}(c.value)}, err: c.err}
 }
 
+{{ end }}
+
+{{ define "Last" -}}
 func Last{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res {{ .TypeLiteral }}) {
+	{{ if .Generic }}return generic.Last(slice)
+	{{- else -}}
 	if len(slice) == 0 {
 		return
 	}
 	res = slice[len(slice) - 1]
 	return
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) Last() *chain{{ .TypeNameCapitalised }} {
-	return &chain{{ .TypeNameCapitalised }}{value: []{{ .TypeLiteral }}{Last{{ .TypeNameCapitalised }}(c.value)}}
+	return &chain{{ .TypeNameCapitalised }}{value: []{{ .TypeLiteral }}{Last{{ .TypeNameCapitalised }}(c.value)}, err: c.err}
 }
 
+{{ end }}
+
+{{ define "Map" -}}
 func Map{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }},int){{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	{{ if .Generic }}return generic.Map(slice, fn)
+	{{- else -}}
 	res = make([]{{ .TypeLiteral }}, 0, len(slice))
 	for index, entry := range slice {
 		res = append(res, fn(entry, index))
 	}
 	return
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) Map(fn func({{ .TypeLiteral }},int){{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
-	return &chain{{ .TypeNameCapitalised }}{value: Map{{ .TypeNameCapitalised }}(c.value, fn)}
+	return &chain{{ .TypeNameCapitalised }}{value: Map{{ .TypeNameCapitalised }}(c.value, fn), err: c.err}
 }
 
+{{ end }}
 
+{{ define "Reduce" -}}
 func Reduce{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }},{{ .TypeLiteral }},int){{ .TypeLiteral }}, initial {{ .TypeLiteral }}) (res {{ .TypeLiteral }}) {
+	{{ if .Generic }}return generic.Reduce(slice, fn, initial)
+	{{- else -}}
 	res = initial
 	for index, entry := range slice {
 		res = fn(res, entry, index)
 	}
 	return
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) Reduce(fn func({{ .TypeLiteral }},{{ .TypeLiteral }},int){{ .TypeLiteral }}, initial {{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
-	return &chain{{ .TypeNameCapitalised }}{value: []{{ .TypeLiteral }}{Reduce{{ .TypeNameCapitalised }}(c.value, fn, initial)}}
+	return &chain{{ .TypeNameCapitalised }}{value: []{{ .TypeLiteral }}{Reduce{{ .TypeNameCapitalised }}(c.value, fn, initial)}, err: c.err}
 }
 
+{{ end }}
+
+{{ define "Reverse" -}}
 func Reverse{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	{{ if .Generic }}return generic.Reverse(slice)
+	{{- else -}}
 	res = make([]{{ .TypeLiteral }}, len(slice))
 	for index, entry := range slice {
 		res[len(slice)-1-index] = entry
 	}
 	return
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) Reverse() *chain{{ .TypeNameCapitalised }} {
-	return &chain{{ .TypeNameCapitalised }}{value: Reverse{{ .TypeNameCapitalised }}(c.value)}
+	return &chain{{ .TypeNameCapitalised }}{value: Reverse{{ .TypeNameCapitalised }}(c.value), err: c.err}
 }
 
+{{ end }}
+
+{{ define "Uniq" -}}
 func Uniq{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
-	seen := make(map[{{ .TypeLiteral }}]bool)
+	{{ if .Generic }}{{ if and .EqualMethod (not .IsPtr) }}return generic.UniqFunc(slice, func(a, b {{ .TypeLiteral }}) bool { return a.Equal(b) })
+	{{- else }}return generic.Uniq(slice){{ end }}
+	{{- else -}}
+	{{ if and .EqualMethod (not .IsPtr) }}res = []{{ .TypeLiteral }}{}
+	outer:
+	for _, entry := range slice {
+		for _, seen := range res {
+			if entry.Equal(seen) {
+				continue outer
+			}
+		}
+		res = append(res, entry)
+	}
+	return
+	{{ else }}seen := make(map[{{ .TypeLiteral }}]bool)
 	res = []{{ .TypeLiteral }}{}
 	for _, entry := range slice {
 		if _, found := seen[entry]; !found {
@@ -187,80 +298,952 @@ func Uniq{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res []{{ .TypeL
 		}
 	}
 	return
+	{{ end }}
+	{{- end }}
 }
 
 func (c *chain{{ .TypeNameCapitalised }}) Uniq() *chain{{ .TypeNameCapitalised }} {
 	if c.isPtr {
 		panic("Uniq() does not currently support pointers")
 	}
-	return &chain{{ .TypeNameCapitalised }}{value: Uniq{{ .TypeNameCapitalised }}(c.value)}
+	return &chain{{ .TypeNameCapitalised }}{value: Uniq{{ .TypeNameCapitalised }}(c.value), err: c.err}
 }
+
+{{ end }}
 `
 
+// METHODS lists the methods defined in TEMPLATES, in the order they are
+// generated.
+var METHODS = []string{
+	"Concat",
+	"Contains",
+	"Drop",
+	"DropRight",
+	"Filter",
+	"First",
+	"Last",
+	"Map",
+	"Reduce",
+	"Reverse",
+	"Uniq",
+	"MapTo",
+	"ReduceTo",
+	"Sort",
+	"SortStable",
+	"IsSorted",
+	"SortBy",
+	"SortStableBy",
+	"IsSortedBy",
+	"SortByKey",
+	"Union",
+	"Intersection",
+	"Difference",
+	"Xor",
+	"IsSubset",
+	"IsDisjoint",
+	"GroupBy",
+	"KeyBy",
+	"CountBy",
+	"Partition",
+	"Find",
+	"FindIndex",
+	"FindLast",
+	"FindLastIndex",
+	"IndexOf",
+	"LastIndexOf",
+	"Every",
+	"Some",
+	"None",
+	"Count",
+	"Take",
+	"TakeRight",
+	"TakeWhile",
+	"TakeRightWhile",
+	"DropWhile",
+	"DropRightWhile",
+	"Slice",
+	"Chunk",
+	"Window",
+	"Zip",
+	"Unzip",
+	"Flatten",
+	"FlattenDeep",
+	"FlatMap",
+	"Sum",
+	"SumChecked",
+	"Product",
+	"Min",
+	"Max",
+	"MinBy",
+	"MaxBy",
+	"Mean",
+	"Median",
+	"Percentile",
+	"Variance",
+	"StdDev",
+	"Shuffle",
+	"Sample",
+	"SampleN",
+	"WeightedChoice",
+	"Lazy",
+	"ParallelForEach",
+	"ParallelMap",
+	"ParallelFilter",
+	"MapErr",
+	"FilterErr",
+	"ReduceErr",
+	"ForEachErr",
+	"MapCtx",
+	"FilterCtx",
+	"ReduceCtx",
+	"ForEachCtx",
+	"ParallelMapCtx",
+	"ParallelFilterCtx",
+	"ParallelForEachCtx",
+	"All",
+	"Values",
+	"FromSeq",
+	"FilterSeq",
+	"MapSeq",
+	"TakeSeq",
+}
+
+// ITER_METHODS are generated with -iter, into a companion file built only with
+// Go 1.23 or later.
+var ITER_METHODS = []string{"All", "Values", "FromSeq", "FilterSeq", "MapSeq", "TakeSeq"}
+
+// METHOD_IMPORTS lists the standard library packages each method's generated
+// code needs.
+var METHOD_IMPORTS = map[string][]string{
+	"Sort":               {"sort"},
+	"SortStable":         {"sort"},
+	"SortBy":             {"sort"},
+	"SortStableBy":       {"sort"},
+	"SortByKey":          {"sort"},
+	"Percentile":         {"sort"},
+	"StdDev":             {"math"},
+	"ParallelForEach":    {"sync", "sync/atomic"},
+	"MapCtx":             {"context"},
+	"FilterCtx":          {"context"},
+	"ReduceCtx":          {"context"},
+	"ForEachCtx":         {"context"},
+	"ParallelMapCtx":     {"context"},
+	"ParallelFilterCtx":  {"context"},
+	"ParallelForEachCtx": {"context"},
+	"All":                {"iter"},
+	"Values":             {"iter"},
+	"FromSeq":            {"iter"},
+	"FilterSeq":          {"iter"},
+	"MapSeq":             {"iter"},
+	"TakeSeq":            {"iter"},
+}
+
+// GENERIC_METHOD_IMPORTS replaces METHOD_IMPORTS with -generic, for methods
+// which then wrap GENERIC_IMPORT. Other methods are generated as without
+// -generic.
+var GENERIC_METHOD_IMPORTS = map[string][]string{
+	"Concat":    {GENERIC_IMPORT},
+	"Contains":  {GENERIC_IMPORT},
+	"Drop":      {GENERIC_IMPORT},
+	"DropRight": {GENERIC_IMPORT},
+	"Filter":    {GENERIC_IMPORT},
+	"First":     {GENERIC_IMPORT},
+	"Last":      {GENERIC_IMPORT},
+	"Map":       {GENERIC_IMPORT},
+	"Reduce":    {GENERIC_IMPORT},
+	"Reverse":   {GENERIC_IMPORT},
+	"Uniq":      {GENERIC_IMPORT},
+	"MapTo":     {GENERIC_IMPORT},
+	"ReduceTo":  {GENERIC_IMPORT},
+}
+
+// TEMPLATES are parsed together to define every method in METHODS.
+var TEMPLATES = []string{
+	TEMPLATE,
+	MAP_TO_TEMPLATE,
+	SORT_TEMPLATE,
+	SET_TEMPLATE,
+	GROUP_TEMPLATE,
+	SEARCH_TEMPLATE,
+	PREDICATE_TEMPLATE,
+	TAKE_TEMPLATE,
+	CHUNK_TEMPLATE,
+	ZIP_TEMPLATE,
+	FLATTEN_TEMPLATE,
+	NUMERIC_TEMPLATE,
+	RANDOM_TEMPLATE,
+	LAZY_TEMPLATE,
+	PARALLEL_TEMPLATE,
+	ERR_TEMPLATE,
+	CONTEXT_TEMPLATE,
+	ITER_TEMPLATE,
+}
+
+// METHOD_DEPENDENCIES lists, for each method, the other methods its
+// generated code calls. These are always generated alongside it.
+var METHOD_DEPENDENCIES = map[string][]string{
+	"Window":             {"Chunk"},
+	"Unzip":              {"Zip"},
+	"Flatten":            {"Chunk"},
+	"Median":             {"Percentile"},
+	"StdDev":             {"Variance"},
+	"ParallelMap":        {"ParallelForEach"},
+	"ParallelFilter":     {"ParallelForEach"},
+	"MapCtx":             {"MapErr"},
+	"FilterCtx":          {"FilterErr"},
+	"ReduceCtx":          {"ReduceErr"},
+	"ForEachCtx":         {"ForEachErr"},
+	"ParallelMapCtx":     {"ParallelForEach"},
+	"ParallelFilterCtx":  {"ParallelForEach"},
+	"ParallelForEachCtx": {"ParallelForEach"},
+}
+
+// stringList is a flag.Value which collects comma-separated values, and may be
+// given more than once.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+// typeSpec describes a single type to generate a slice chain for, and where
+// the generated code should be written.
+type typeSpec struct {
+	Type     string
+	Imports  []string
+	Package  string
+	BuildTag string
+	Dir      string
+	Out      string
+	Methods  []string
+	Exclude  []string
+	Generic  bool
+	Iter     bool
+	MapTo    []string
+	KeyTypes []string
+	ZipWith  []string
+}
+
+// typeData is passed to TEMPLATE for each generated type.
+type typeData struct {
+	typeInfo
+
+	IsPtr               bool
+	Generic             bool
+	Iter                bool
+	TypeName            string
+	TypeNameCapitalised string
+	TypeLiteral         string
+	NewFuncName         string
+	Methods             []string
+	MapTo               []*typeData
+	KeyTypes            []*typeData
+	ZipWith             []*typeData
+
+	spec typeSpec
+}
+
+func newTypeData(typeLiteral string) *typeData {
+	isPtr := typeLiteral[0] == '*'
+
+	typeName := typeLiteral
+	if isPtr {
+		typeName = typeLiteral[1:] + "Ptr"
+	}
+
+	typeNameCapitalised := strings.ToUpper(typeName[0:1]) + typeName[1:]
+
+	return &typeData{
+		IsPtr:               isPtr,
+		TypeName:            typeName,
+		TypeNameCapitalised: typeNameCapitalised,
+		TypeLiteral:         typeLiteral,
+		NewFuncName:         "New" + typeNameCapitalised + "Slice",
+	}
+}
+
+// outputFile is a single generated file, which may hold several types.
+// Companion files hold just the ITER_METHODS of the types in another.
+type outputFile struct {
+	Path         string
+	Package      string
+	BuildTag     string
+	Generic      bool
+	Iter         bool
+	Companion    bool
+	Imports      []string
+	PlainImports []string
+	Types        []*typeData
+}
+
+// groupOutputs collects specs into the files they will be written to. Specs
+// without an explicit output filename are written to a file of their own.
+func groupOutputs(specs []typeSpec) ([]*outputFile, error) {
+	var files []*outputFile
+	byPath := map[string]*outputFile{}
+
+	for _, spec := range specs {
+		if spec.Type == "" {
+			return nil, fmt.Errorf("empty type name")
+		}
+
+		data := newTypeData(spec.Type)
+		data.Generic = spec.Generic
+		data.Iter = spec.Iter
+		data.spec = spec
+
+		for _, target := range spec.MapTo {
+			if target == "" {
+				return nil, fmt.Errorf("%s: empty map-to type", spec.Type)
+			}
+			if target != spec.Type {
+				data.MapTo = append(data.MapTo, newTypeData(target))
+			}
+		}
+		for _, key := range spec.KeyTypes {
+			if key == "" {
+				return nil, fmt.Errorf("%s: empty key type", spec.Type)
+			}
+			data.KeyTypes = append(data.KeyTypes, newTypeData(key))
+		}
+		for _, other := range spec.ZipWith {
+			if other == "" {
+				return nil, fmt.Errorf("%s: empty zip-with type", spec.Type)
+			}
+			data.ZipWith = append(data.ZipWith, newTypeData(other))
+		}
+
+		out := spec.Out
+		if out == "" {
+			out = data.TypeName + ".go"
+		}
+		p := path.Join(spec.Dir, out)
+
+		f, ok := byPath[p]
+		if !ok {
+			f = &outputFile{Path: p, Package: spec.Package, BuildTag: spec.BuildTag, Generic: spec.Generic, Iter: spec.Iter}
+			byPath[p] = f
+			files = append(files, f)
+		}
+
+		if f.Package != spec.Package {
+			return nil, fmt.Errorf("%s: conflicting packages %s and %s", p, f.Package, spec.Package)
+		}
+		if f.BuildTag != spec.BuildTag {
+			return nil, fmt.Errorf("%s: conflicting build tags %q and %q", p, f.BuildTag, spec.BuildTag)
+		}
+		if f.Generic != spec.Generic {
+			return nil, fmt.Errorf("%s: cannot mix generic and non-generic types", p)
+		}
+		if f.Iter != spec.Iter {
+			return nil, fmt.Errorf("%s: cannot mix types with and without iterators", p)
+		}
+
+		for _, t := range f.Types {
+			if t.TypeNameCapitalised == data.TypeNameCapitalised {
+				return nil, fmt.Errorf("%s: type %s given more than once", p, spec.Type)
+			}
+		}
+		f.Types = append(f.Types, data)
+
+		for _, imp := range spec.Imports {
+			if !containsString(f.Imports, imp) {
+				f.Imports = append(f.Imports, imp)
+			}
+		}
+	}
+
+	for _, f := range files {
+		if _, ok := byPath[companionPath(f.Path)]; f.Iter && ok {
+			return nil, fmt.Errorf("%s: also needed for the iterators of %s", companionPath(f.Path), f.Path)
+		}
+	}
+
+	return files, nil
+}
+
+// companionPath is where the companion of the file at p is written: beside
+// it, with "_iter" added to its name.
+func companionPath(p string) string {
+	if strings.HasSuffix(p, "_test.go") {
+		return strings.TrimSuffix(p, "_test.go") + "_iter_test.go"
+	}
+	return strings.TrimSuffix(p, ".go") + "_iter.go"
+}
+
+// resolveFile loads the types to be generated in f, and selects the methods
+// to generate for each. Methods which cannot be generated for a type, and
+// which were not explicitly asked for, are left out and returned as warnings,
+// unless they only apply to some kinds of type anyway.
+func resolveFile(f *outputFile) (warnings []string, err error) {
+	var all []*typeData
+	var literals []string
+	for _, data := range f.Types {
+		for _, d := range append([]*typeData{data}, data.KeyTypes...) {
+			all = append(all, d)
+			literals = append(literals, d.TypeLiteral)
+		}
+	}
+
+	loaded, err := loadTypes(f, literals)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", f.Path, err)
+	}
+	// types from the package itself, and from dot imports, need no qualifier
+	local := func(pkg *types.Package) bool {
+		return pkg.Path() == f.Package || containsString(f.Imports, pkg.Path())
+	}
+	for i, d := range all {
+		d.typeInfo = newTypeInfo(loaded[i], local)
+	}
+
+	for _, data := range f.Types {
+		inapplicable := data.inapplicableMethods()
+
+		unavailable := data.unavailableMethods()
+		for m, reason := range inapplicable {
+			unavailable[m] = reason
+		}
+
+		methods, skipped, err := selectMethods(data.spec.Methods, data.spec.Exclude, unavailable)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", data.TypeLiteral, err)
+		}
+		data.Methods = methods
+
+		for _, m := range METHODS {
+			if reason, ok := skipped[m]; ok {
+				if _, ok := inapplicable[m]; !ok {
+					warnings = append(warnings, fmt.Sprintf("%s: skipping %s: %s", data.TypeLiteral, m, reason))
+				}
+			}
+		}
+	}
+	collectImports(f)
+
+	return warnings, nil
+}
+
+// collectImports sets the standard library packages needed by the methods of
+// the types in f.
+func collectImports(f *outputFile) {
+	f.PlainImports = nil
+	for _, data := range f.Types {
+		for _, m := range data.Methods {
+			imports := METHOD_IMPORTS[m]
+			if data.Generic {
+				if genericImports, ok := GENERIC_METHOD_IMPORTS[m]; ok {
+					imports = genericImports
+				}
+			}
+
+			for _, imp := range imports {
+				if !containsString(f.PlainImports, imp) {
+					f.PlainImports = append(f.PlainImports, imp)
+				}
+			}
+		}
+	}
+	sort.Strings(f.PlainImports)
+}
+
+// splitCompanion moves the ITER_METHODS selected for the types in f into a
+// companion file, so that f itself still builds with older versions of Go.
+// It returns nil if no type in f has any.
+func splitCompanion(f *outputFile) *outputFile {
+	companion := &outputFile{
+		Path:      companionPath(f.Path),
+		Package:   f.Package,
+		BuildTag:  f.BuildTag,
+		Generic:   f.Generic,
+		Iter:      f.Iter,
+		Companion: true,
+		Imports:   f.Imports,
+	}
+
+	for _, data := range f.Types {
+		var methods, iterMethods []string
+		for _, m := range data.Methods {
+			if containsString(ITER_METHODS, m) {
+				iterMethods = append(iterMethods, m)
+			} else {
+				methods = append(methods, m)
+			}
+		}
+		if len(iterMethods) == 0 {
+			continue
+		}
+
+		data.Methods = methods
+		iterData := *data
+		iterData.Methods = iterMethods
+		companion.Types = append(companion.Types, &iterData)
+	}
+	if len(companion.Types) == 0 {
+		return nil
+	}
+
+	collectImports(f)
+	collectImports(companion)
+	return companion
+}
+
+// unavailableMethods returns the methods which cannot be generated for the
+// type, with the reason why.
+func (d *typeData) unavailableMethods() map[string]string {
+	res := map[string]string{}
+
+	elem := strings.TrimPrefix(d.TypeLiteral, "*")
+	if !d.Comparable && !d.EqualMethod {
+		reason := fmt.Sprintf("%s is not comparable and has no Equal(%s) bool method", elem, elem)
+
+		res["Contains"] = reason
+		if !d.IsPtr {
+			res["Uniq"] = reason
+		}
+		for _, m := range []string{"Union", "Intersection", "Difference", "Xor", "IsSubset", "IsDisjoint", "IndexOf", "LastIndexOf"} {
+			res[m] = reason
+		}
+	}
+
+	return res
+}
+
+// inapplicableMethods returns the methods which only make sense for some
+// kinds of type, and which the type is not, with the reason why. Unlike
+// unavailableMethods, these are left out without a warning.
+func (d *typeData) inapplicableMethods() map[string]string {
+	res := map[string]string{}
+
+	elem := strings.TrimPrefix(d.TypeLiteral, "*")
+	if !d.Ordered && !d.LessMethod && !d.CompareMethod {
+		reason := fmt.Sprintf("%s is not ordered and has no Less(%s) bool or Compare(%s) int method", elem, elem, elem)
+
+		res["Sort"] = reason
+		res["SortStable"] = reason
+		res["IsSorted"] = reason
+		res["Min"] = reason
+		res["Max"] = reason
+	}
+
+	if !d.Numeric || d.IsPtr {
+		reason := fmt.Sprintf("%s is not a number", d.TypeLiteral)
+		for _, m := range []string{"Sum", "SumChecked", "Product", "Mean", "Median", "Percentile", "Variance", "StdDev"} {
+			res[m] = reason
+		}
+	} else if !d.Integer {
+		res["SumChecked"] = fmt.Sprintf("%s is not an integer", d.TypeLiteral)
+	}
+
+	if d.SliceDepth == 0 {
+		res["FlattenDeep"] = fmt.Sprintf("%s is not made of slices", d.TypeLiteral)
+	}
+
+	if !d.Iter {
+		for _, m := range ITER_METHODS {
+			res[m] = "iterators need -iter"
+		}
+	}
+
+	// these generate a variant for each type given, so need at least one
+	ordered, comparable := false, false
+	for _, key := range d.KeyTypes {
+		ordered = ordered || key.Ordered || key.LessMethod || key.CompareMethod
+		comparable = comparable || key.Comparable
+	}
+	if !ordered {
+		res["SortByKey"] = "needs -key-types with an ordered key type"
+	}
+	if !comparable {
+		for _, m := range []string{"GroupBy", "KeyBy", "CountBy"} {
+			res[m] = "needs -key-types with a comparable key type"
+		}
+	}
+	if len(d.MapTo) == 0 {
+		res["MapTo"] = "needs -map-to"
+		res["ReduceTo"] = "needs -map-to"
+	}
+	if len(d.ZipWith) == 0 {
+		res["Zip"] = "needs -zip-with"
+		res["Unzip"] = "needs -zip-with"
+	}
+
+	return res
+}
+
+// selectMethods validates the requested methods and returns them, along with
+// any methods they depend on, in generation order. No methods means all of
+// them, less those excluded and those depending on them. Methods which are
+// unavailable, or depend on one which is, are returned in skipped, unless
+// explicitly requested in which case it is an error.
+func selectMethods(methods []string, exclude []string, unavailable map[string]string) (res []string, skipped map[string]string, err error) {
+	for _, m := range append(append([]string{}, methods...), exclude...) {
+		if !containsString(METHODS, m) {
+			return nil, nil, fmt.Errorf("unknown method %s", m)
+		}
+	}
+
+	selected := map[string]bool{}
+	for _, m := range METHODS {
+		if (len(methods) == 0 || containsString(methods, m)) && !containsString(exclude, m) {
+			selected[m] = true
+		}
+	}
+
+	// add dependencies until there are no more to add; methods depending on
+	// an excluded one are left out too, unless explicitly asked for
+	dropped := map[string]bool{}
+	for added := true; added; {
+		added = false
+		for m := range selected {
+			for _, dep := range METHOD_DEPENDENCIES[m] {
+				if containsString(exclude, dep) || dropped[dep] {
+					if containsString(methods, m) {
+						return nil, nil, fmt.Errorf("cannot exclude %s, %s depends on it", dep, m)
+					}
+					delete(selected, m)
+					dropped[m] = true
+					added = true
+					break
+				}
+				if !selected[dep] {
+					selected[dep] = true
+					added = true
+				}
+			}
+		}
+	}
+
+	// then remove unavailable methods, and those depending on them
+	skipped = map[string]string{}
+	for removed := true; removed; {
+		removed = false
+		for m := range selected {
+			reason := unavailable[m]
+			for _, dep := range METHOD_DEPENDENCIES[m] {
+				if _, ok := skipped[dep]; ok && reason == "" {
+					reason = "depends on " + dep
+				}
+			}
+			if reason == "" {
+				continue
+			}
+
+			if containsString(methods, m) {
+				return nil, nil, fmt.Errorf("cannot generate %s: %s", m, reason)
+			}
+			delete(selected, m)
+			skipped[m] = reason
+			removed = true
+		}
+	}
+
+	for _, m := range METHODS {
+		if selected[m] {
+			res = append(res, m)
+		}
+	}
+	return res, skipped, nil
+}
+
+func containsString(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
+
+// generateFile renders the header followed by the chain type and
+// selected methods for each type in f.
+func generateFile(f *outputFile) ([]byte, error) {
+	header, err := template.New("header").Parse(HEADER_TEMPLATE)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template: %s", err)
+	}
+
+	t := template.New("go-dash-slice")
+	for _, text := range TEMPLATES {
+		_, err = t.Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load template: %s", err)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	err = header.Execute(&buf, f)
+	if err != nil {
+		return nil, fmt.Errorf("template execute: %s", err)
+	}
+
+	for _, data := range f.Types {
+		names := data.Methods
+		if !f.Companion {
+			names = append([]string{"chain"}, names...)
+		}
+		for _, name := range names {
+			err = t.ExecuteTemplate(&buf, name, data)
+			if err != nil {
+				return nil, fmt.Errorf("template execute: %s", err)
+			}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// generate renders, formats and verifies the files for specs, without
+// writing them.
+func generate(specs []typeSpec) (generated []generatedFile, warnings []string, err error) {
+	files, err := groupOutputs(specs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, file := range files {
+		w, err := resolveFile(file)
+		warnings = append(warnings, w...)
+		if err != nil {
+			return nil, warnings, err
+		}
+
+		outputs := []*outputFile{file}
+		if companion := splitCompanion(file); companion != nil {
+			outputs = append(outputs, companion)
+		}
+
+		for _, f := range outputs {
+			content, err := generateFile(f)
+			if err != nil {
+				return nil, warnings, err
+			}
+
+			content, err = formatFile(f, content)
+			if err != nil {
+				return nil, warnings, err
+			}
+
+			generated = append(generated, generatedFile{file: f, content: content})
+		}
+	}
+
+	err = verifyFiles(generated)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return generated, warnings, nil
+}
+
+// writeFiles writes generated files, replacing any existing content.
+func writeFiles(generated []generatedFile) error {
+	for _, g := range generated {
+		err := os.MkdirAll(path.Dir(g.file.Path), 0755)
+		if err != nil {
+			return fmt.Errorf("mkdir: %s", err)
+		}
+
+		err = ioutil.WriteFile(g.file.Path, g.content, 0644)
+		if err != nil {
+			return fmt.Errorf("write: %s", err)
+		}
+	}
+	return nil
+}
+
+// checkFiles compares generated files with those on disk, writing a diff to w
+// and returning the paths of any which differ.
+func checkFiles(generated []generatedFile, w io.Writer) (stale []string, err error) {
+	for _, g := range generated {
+		existing, err := ioutil.ReadFile(g.file.Path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		diff := unifiedDiff(g.file.Path, g.file.Path+" (generated)", existing, g.content)
+		if diff != "" {
+			fmt.Fprint(w, diff)
+			stale = append(stale, g.file.Path)
+		}
+	}
+	return stale, nil
+}
+
+// parseImports splits -import values into imports for every generated type,
+// and imports keyed by the (non-pointer) type name they are needed for, given
+// as Type=path.
+func parseImports(imports []string) (global []string, byType map[string]string) {
+	byType = map[string]string{}
+	for _, imp := range imports {
+		if i := strings.Index(imp, "="); i >= 0 {
+			byType[strings.TrimPrefix(imp[:i], "*")] = imp[i+1:]
+		} else {
+			global = append(global, imp)
+		}
+	}
+	return
+}
+
 func main() {
 	var flagPkg string
 	var flagBuildTag string
-	var flagImport string
-	var flagTypeName string
+	var flagImports stringList
+	var flagTypeNames stringList
 	var flagOutputDir string
 	var flagOutputFile string
+	var flagConfig string
+	var flagDiscover bool
+	var flagMethods stringList
+	var flagExclude stringList
+	var flagCheck bool
+	var flagGeneric bool
+	var flagIter bool
+	var flagMapTo stringList
+	var flagKeyTypes stringList
+	var flagZipWith stringList
+
+	// go generate tells us which package we're generating for, in which case
+	// generate into that package by default
+	defaultPkg, defaultDir := "godash", "go-dash-slice"
+	if pkg := os.Getenv("GOPACKAGE"); pkg != "" {
+		defaultPkg = pkg
+		defaultDir = path.Dir(os.Getenv("GOFILE"))
+	}
 
-	flag.StringVar(&flagPkg, "package", "godash", "set the package name on generated files")
+	flag.StringVar(&flagPkg, "package", defaultPkg, "set the package name on generated files (defaults to $GOPACKAGE under go generate)")
 	flag.StringVar(&flagBuildTag, "build-tag", "", "add a build tag to generates files")
-	flag.StringVar(&flagImport, "import", "", "add an import to generated files (needed for custom types)")
-	flag.StringVar(&flagTypeName, "type", "string", "the type of slice to generate for")
-	flag.StringVar(&flagOutputDir, "dir", "go-dash-slice", "output directory (created if needed)")
-	flag.StringVar(&flagOutputFile, "out", "", "output filename")
+	flag.Var(&flagImports, "import", "add an import to generated files (needed for custom types); use Type=path to import only for a given type")
+	flag.Var(&flagTypeNames, "type", "the type(s) of slice to generate for, comma-separated or repeated (default string)")
+	flag.StringVar(&flagOutputDir, "dir", defaultDir, "output directory, created if needed (defaults to the current package under go generate)")
+	flag.StringVar(&flagOutputFile, "out", "", "output filename; all types are written to this file if set, otherwise each type gets its own file")
+	flag.StringVar(&flagConfig, "config", "", "read types to generate from a slice.yaml or slice.json file; other flags act as defaults")
+	flag.Var(&flagMethods, "methods", "only generate these methods (and those they depend on), comma-separated or repeated (default all)")
+	flag.Var(&flagExclude, "exclude", "do not generate these methods, comma-separated or repeated")
+	flag.BoolVar(&flagCheck, "check", os.Getenv("SLICE_CHECK") != "", "do not write anything, but print a diff and exit non-zero if generated files are out of date (defaults to true if $SLICE_CHECK is set)")
+	flag.BoolVar(&flagGeneric, "generic", false, "generate the core functions as thin wrappers around those in "+GENERIC_IMPORT+", and the rest in full (needs a module at go 1.18 or later, or Go 1.21 or later to build)")
+	flag.BoolVar(&flagIter, "iter", false, "also generate methods working with iter.Seq, into a companion _iter.go file built only with Go 1.23 or later")
+	flag.Var(&flagMapTo, "map-to", "generate Map and Reduce variants to these types, comma-separated or repeated (their chain types must be generated into the same package)")
+	flag.Var(&flagKeyTypes, "key-types", "generate methods taking key functions for these key types, comma-separated or repeated")
+	flag.Var(&flagZipWith, "zip-with", "generate Zip and Unzip with these types, comma-separated or repeated (their chain types must be generated into the same package)")
+	flag.BoolVar(&flagDiscover, "discover", false, "generate for types in the current package annotated with "+MARKER)
 
 	flag.Parse()
 
-	isPtr := false
-	if flagTypeName[0] == '*' {
-		isPtr = true
+	flagsSet := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) {
+		flagsSet[f.Name] = true
+	})
+
+	globalImports, typeImports := parseImports(flagImports)
+
+	defaults := typeSpec{
+		Imports:  globalImports,
+		Package:  flagPkg,
+		BuildTag: flagBuildTag,
+		Dir:      flagOutputDir,
+		Out:      flagOutputFile,
+		Methods:  flagMethods,
+		Exclude:  flagExclude,
+		Generic:  flagGeneric,
+		Iter:     flagIter,
+		MapTo:    flagMapTo,
+		KeyTypes: flagKeyTypes,
+		ZipWith:  flagZipWith,
 	}
 
-	typeLiteral := flagTypeName
-	if isPtr {
-		flagTypeName = flagTypeName[1:] + "Ptr"
+	var specs []typeSpec
+
+	if flagConfig != "" {
+		c, err := loadConfig(flagConfig)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "config: %s", err.Error())
+			os.Exit(1)
+		}
+
+		specs, err = c.specs(defaults, path.Dir(flagConfig))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "config: %s: %s", flagConfig, err.Error())
+			os.Exit(1)
+		}
 	}
 
-	if flagOutputFile == "" {
-		flagOutputFile = flagTypeName + ".go"
+	if flagDiscover {
+		pkg, err := discoverTypes(".")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "discover: %s", err.Error())
+			os.Exit(1)
+		}
+
+		// discovered types live in the current package, so generate
+		// alongside them unless told otherwise
+		discovered := defaults
+		if !flagsSet["package"] {
+			discovered.Package = pkg.Name
+		}
+		if !flagsSet["dir"] {
+			discovered.Dir = "."
+		}
+		if !flagsSet["out"] {
+			discovered.Out = DISCOVER_OUT
+		}
+
+		for _, spec := range pkg.Specs {
+			specs = append(specs, mergeSpec(discovered, spec))
+		}
 	}
 
-	t := template.New("go-dash-slice")
-	t, err := t.Parse(TEMPLATE)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "failed to load template: %s", err.Error())
-		os.Exit(1)
+	if flagConfig == "" && !flagDiscover && len(flagTypeNames) == 0 {
+		flagTypeNames = stringList{"string"}
 	}
 
-	err = os.MkdirAll(flagOutputDir, 0755)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "mkdir: %s", err.Error())
-		os.Exit(1)
+	for _, typeName := range flagTypeNames {
+		specs = append(specs, mergeSpec(defaults, typeSpec{Type: typeName}))
+	}
+
+	for i := range specs {
+		if imp, ok := typeImports[strings.TrimPrefix(specs[i].Type, "*")]; ok {
+			specs[i] = mergeSpec(specs[i], typeSpec{Imports: []string{imp}})
+		}
 	}
 
-	f, err := os.OpenFile(path.Join(flagOutputDir, flagOutputFile), os.O_CREATE|os.O_WRONLY, 0644)
+	generated, warnings, err := generate(specs)
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "open: %s", err.Error())
+		fmt.Fprintf(os.Stderr, "%s", err.Error())
 		os.Exit(1)
 	}
 
-	typeNameCapitalised := strings.ToUpper(flagTypeName[0:1]) + flagTypeName[1:]
+	if flagCheck {
+		stale, err := checkFiles(generated, os.Stdout)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "check: %s", err.Error())
+			os.Exit(1)
+		}
+		for _, p := range stale {
+			fmt.Fprintf(os.Stderr, "%s is out of date, re-run go generate\n", p)
+		}
+		if len(stale) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
 
-	err = t.Execute(f, map[string]interface{}{
-		"BuildTag":            flagBuildTag,
-		"IsPtr":               isPtr,
-		"TypeNameCapitalised": typeNameCapitalised,
-		"TypeLiteral":         typeLiteral,
-		"TypeName":            flagTypeName,
-		"Package":             flagPkg,
-		"Import":              flagImport,
-		"NewFuncName":         "New" + typeNameCapitalised + "Slice",
-	})
+	err = writeFiles(generated)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "template execute: %s", err.Error())
+		fmt.Fprintf(os.Stderr, "%s", err.Error())
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,734 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupOutputs(t *testing.T) {
+	var tests = []struct {
+		name  string
+		specs []typeSpec
+		paths []string
+		types [][]string
+	}{
+		{
+			"should write each type to its own file when no output file is given",
+			[]typeSpec{
+				{Type: "string", Package: "p", Dir: "out"},
+				{Type: "*User", Package: "p", Dir: "out"},
+			},
+			[]string{"out/string.go", "out/UserPtr.go"},
+			[][]string{{"String"}, {"UserPtr"}},
+		},
+		{
+			"should write all types to a shared output file",
+			[]typeSpec{
+				{Type: "string", Package: "p", Dir: "out", Out: "all.go"},
+				{Type: "int", Package: "p", Dir: "out", Out: "all.go"},
+				{Type: "*User", Package: "p", Dir: "out", Out: "all.go"},
+			},
+			[]string{"out/all.go"},
+			[][]string{{"String", "Int", "UserPtr"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files, err := groupOutputs(test.specs)
+			require.NoError(t, err)
+
+			paths := []string{}
+			types := [][]string{}
+			for _, f := range files {
+				paths = append(paths, f.Path)
+
+				names := []string{}
+				for _, data := range f.Types {
+					names = append(names, data.TypeNameCapitalised)
+				}
+				types = append(types, names)
+			}
+
+			require.Equal(t, test.paths, paths)
+			require.Equal(t, test.types, types)
+		})
+	}
+}
+
+func TestGroupOutputsConflicts(t *testing.T) {
+	var tests = []struct {
+		name  string
+		specs []typeSpec
+	}{
+		{
+			"should fail on conflicting packages",
+			[]typeSpec{
+				{Type: "string", Package: "a", Out: "all.go"},
+				{Type: "int", Package: "b", Out: "all.go"},
+			},
+		},
+		{
+			"should fail on conflicting build tags",
+			[]typeSpec{
+				{Type: "string", Package: "a", BuildTag: "x", Out: "all.go"},
+				{Type: "int", Package: "a", Out: "all.go"},
+			},
+		},
+		{
+			"should fail on duplicate types",
+			[]typeSpec{
+				{Type: "string", Package: "a", Out: "all.go"},
+				{Type: "string", Package: "a", Out: "all.go"},
+			},
+		},
+		{
+			"should fail on empty map-to types",
+			[]typeSpec{
+				{Type: "string", Package: "a", MapTo: []string{"int", ""}},
+			},
+		},
+		{
+			"should fail on empty key types",
+			[]typeSpec{
+				{Type: "string", Package: "a", KeyTypes: []string{""}},
+			},
+		},
+		{
+			"should fail on empty zip-with types",
+			[]typeSpec{
+				{Type: "string", Package: "a", ZipWith: []string{""}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := groupOutputs(test.specs)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseImports(t *testing.T) {
+	global, byType := parseImports([]string{"example.com/all", "User=example.com/user", "*Order=example.com/order"})
+
+	require.Equal(t, []string{"example.com/all"}, global)
+	require.Equal(t, map[string]string{"User": "example.com/user", "Order": "example.com/order"}, byType)
+}
+
+func TestGenerateFileMultipleTypes(t *testing.T) {
+	files, err := groupOutputs([]typeSpec{
+		{Type: "string", Package: "p", Out: "all.go"},
+		{Type: "*CustomType", Package: "p", Out: "all.go", Imports: []string{"github.com/jtyers/slice/customtype"}},
+		{Type: "TaggedType", Package: "p", Out: "all.go", Imports: []string{"github.com/jtyers/slice/customtype"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	_, err = resolveFile(files[0])
+	require.NoError(t, err)
+
+	content, err := generateFile(files[0])
+	require.NoError(t, err)
+
+	f, err := parser.ParseFile(token.NewFileSet(), "all.go", content, 0)
+	require.NoError(t, err)
+
+	imports := []string{}
+	for _, imp := range f.Imports {
+		imports = append(imports, imp.Path.Value)
+	}
+	require.Equal(t, []string{`"context"`, `"sort"`, `"sync"`, `"sync/atomic"`, `"github.com/jtyers/slice/customtype"`}, imports)
+
+	decls := map[string]bool{}
+	for name := range f.Scope.Objects {
+		decls[name] = true
+	}
+	for _, name := range []string{"chainString", "NewStringSlice", "chainCustomTypePtr", "NewCustomTypePtrSlice", "chainTaggedType", "UniqTaggedType"} {
+		require.True(t, decls[name], "expected %s to be declared", name)
+	}
+}
+
+func methodsWithout(exclude ...string) (res []string) {
+	for _, m := range METHODS {
+		if !containsString(exclude, m) {
+			res = append(res, m)
+		}
+	}
+	return
+}
+
+func TestSelectMethods(t *testing.T) {
+	var tests = []struct {
+		name    string
+		methods []string
+		exclude []string
+		output  []string
+	}{
+		{
+			"should select all methods by default",
+			nil,
+			nil,
+			METHODS,
+		},
+		{
+			"should select given methods in generation order",
+			[]string{"Uniq", "Filter"},
+			nil,
+			[]string{"Filter", "Uniq"},
+		},
+		{
+			"should leave out excluded methods",
+			nil,
+			[]string{"Contains", "Uniq"},
+			methodsWithout("Contains", "Uniq"),
+		},
+		{
+			"should leave out excluded methods from those given",
+			[]string{"Filter", "Map", "Uniq"},
+			[]string{"Uniq"},
+			[]string{"Filter", "Map"},
+		},
+		{
+			"should add the methods those given depend on",
+			[]string{"Window"},
+			nil,
+			[]string{"Chunk", "Window"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, skipped, err := selectMethods(test.methods, test.exclude, nil)
+			require.NoError(t, err)
+			require.Empty(t, skipped)
+			require.Equal(t, test.output, got)
+		})
+	}
+}
+
+func TestSelectMethodsDependencies(t *testing.T) {
+	saved := METHOD_DEPENDENCIES
+	defer func() { METHOD_DEPENDENCIES = saved }()
+
+	METHOD_DEPENDENCIES = map[string][]string{
+		"Uniq":   {"Contains"},
+		"Filter": {"Map"},
+		"Map":    {"First"},
+	}
+
+	got, _, err := selectMethods([]string{"Uniq", "Filter"}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Contains", "Filter", "First", "Map", "Uniq"}, got)
+
+	_, _, err = selectMethods([]string{"Uniq"}, []string{"Contains"}, nil)
+	require.Error(t, err)
+
+	// without being asked for, methods depending on excluded ones are left out
+	got, _, err = selectMethods(nil, []string{"First"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, methodsWithout("First", "Map", "Filter"), got)
+
+	_, _, err = selectMethods([]string{"Filter"}, []string{"First"}, nil)
+	require.Error(t, err)
+
+	got, skipped, err := selectMethods(nil, nil, map[string]string{"First": "no"})
+	require.NoError(t, err)
+	require.NotContains(t, got, "First")
+	require.NotContains(t, got, "Map")
+	require.NotContains(t, got, "Filter")
+	require.Equal(t, map[string]string{"First": "no", "Map": "depends on First", "Filter": "depends on Map"}, skipped)
+
+	_, _, err = selectMethods([]string{"Filter"}, nil, map[string]string{"First": "no"})
+	require.Error(t, err)
+}
+
+func TestSelectMethodsUnknown(t *testing.T) {
+	_, _, err := selectMethods([]string{"Explode"}, nil, nil)
+	require.Error(t, err)
+
+	_, _, err = selectMethods(nil, []string{"Explode"}, nil)
+	require.Error(t, err)
+}
+
+func TestResolveFileComparability(t *testing.T) {
+	var tests = []struct {
+		name     string
+		spec     typeSpec
+		info     typeInfo
+		warnings int
+		err      bool
+	}{
+		{
+			"should find comparable types",
+			typeSpec{Type: "CustomType"},
+			typeInfo{Comparable: true, LessMethod: true},
+			0,
+			false,
+		},
+		{
+			"should use Equal for non-comparable types which have it",
+			typeSpec{Type: "TaggedType"},
+			typeInfo{EqualMethod: true},
+			0,
+			false,
+		},
+		{
+			"should use Equal for pointers to non-comparable types",
+			typeSpec{Type: "*TaggedType"},
+			typeInfo{EqualMethod: true},
+			0,
+			false,
+		},
+		{
+			"should skip methods comparing elements for non-comparable types",
+			typeSpec{Type: "[]string"},
+			typeInfo{SliceDepth: 1, SliceElem: "string"},
+			10,
+			false,
+		},
+		{
+			"should fail if Contains is asked for on a non-comparable type",
+			typeSpec{Type: "[]string", Methods: []string{"Contains"}},
+			typeInfo{},
+			0,
+			true,
+		},
+		{
+			"should fail on unknown types",
+			typeSpec{Type: "Unknown"},
+			typeInfo{},
+			0,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec := test.spec
+			spec.Package = "p"
+			spec.Out = "all.go"
+			spec.Imports = []string{"github.com/jtyers/slice/customtype"}
+
+			files, err := groupOutputs([]typeSpec{spec})
+			require.NoError(t, err)
+
+			warnings, err := resolveFile(files[0])
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, warnings, test.warnings)
+			require.Equal(t, test.info, files[0].Types[0].typeInfo)
+		})
+	}
+}
+
+func TestResolveFileOrdering(t *testing.T) {
+	var tests = []struct {
+		name   string
+		spec   typeSpec
+		info   typeInfo
+		sorted bool
+		err    bool
+	}{
+		{"should find ordered types", typeSpec{Type: "string"}, typeInfo{Comparable: true, Ordered: true}, true, false},
+		{"should find ordered pointer types", typeSpec{Type: "*float64"}, typeInfo{Comparable: true, Ordered: true, Numeric: true}, true, false},
+		{"should use Less where there is one", typeSpec{Type: "CustomType"}, typeInfo{Comparable: true, LessMethod: true}, true, false},
+		{"should quietly skip Sort for unordered types", typeSpec{Type: "TaggedType"}, typeInfo{EqualMethod: true}, false, false},
+		{"should fail if Sort is asked for on an unordered type", typeSpec{Type: "TaggedType", Methods: []string{"Sort"}}, typeInfo{}, false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec := test.spec
+			spec.Package = "p"
+			spec.Out = "all.go"
+			spec.Imports = []string{"github.com/jtyers/slice/customtype"}
+
+			files, err := groupOutputs([]typeSpec{spec})
+			require.NoError(t, err)
+
+			warnings, err := resolveFile(files[0])
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, warnings)
+			require.Equal(t, test.info, files[0].Types[0].typeInfo)
+
+			for _, m := range []string{"Sort", "SortStable", "IsSorted"} {
+				require.Equal(t, test.sorted, containsString(files[0].Types[0].Methods, m), m)
+			}
+			require.Contains(t, files[0].Types[0].Methods, "SortBy")
+		})
+	}
+}
+
+func TestResolveFileNumeric(t *testing.T) {
+	var tests = []struct {
+		name    string
+		spec    typeSpec
+		sum     bool
+		checked bool
+	}{
+		{"should find named integer types", typeSpec{Type: "Cents"}, true, true},
+		{"should find unsigned integer types", typeSpec{Type: "uint8"}, true, true},
+		{"should find float types", typeSpec{Type: "float32"}, true, false},
+		{"should skip pointers to numbers", typeSpec{Type: "*int"}, false, false},
+		{"should skip other types", typeSpec{Type: "string"}, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec := test.spec
+			spec.Package = "p"
+			spec.Out = "all.go"
+			spec.Imports = []string{"github.com/jtyers/slice/customtype"}
+
+			files, err := groupOutputs([]typeSpec{spec})
+			require.NoError(t, err)
+
+			warnings, err := resolveFile(files[0])
+			require.NoError(t, err)
+			require.Empty(t, warnings)
+
+			methods := files[0].Types[0].Methods
+			for _, m := range []string{"Sum", "Product", "Mean", "Median", "Percentile", "Variance", "StdDev"} {
+				require.Equal(t, test.sum, containsString(methods, m), m)
+			}
+			require.Equal(t, test.checked, containsString(methods, "SumChecked"))
+			require.Contains(t, methods, "MinBy")
+		})
+	}
+}
+
+func TestGenerateFormatsAndVerifies(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"user.go": "package models\n\ntype User struct {\n\tName string\n}\n",
+	})
+
+	generated, warnings, err := generate([]typeSpec{
+		{Type: "User", Package: "models", Dir: dir, Out: "slices.go"},
+		{Type: "*CustomType", Package: "models", Dir: dir, Out: "slices.go", Imports: []string{"github.com/jtyers/slice/customtype"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+	require.Len(t, generated, 1)
+
+	formatted, err := format.Source(generated[0].content)
+	require.NoError(t, err)
+	require.Equal(t, string(formatted), string(generated[0].content))
+}
+
+func TestGenerateVerifyErrors(t *testing.T) {
+	var tests = []struct {
+		name  string
+		files map[string]string
+		spec  typeSpec
+	}{
+		{
+			"should fail on unused imports",
+			map[string]string{},
+			typeSpec{Type: "string", Imports: []string{"github.com/jtyers/slice/customtype"}},
+		},
+		{
+			"should fail on declarations clashing with the package",
+			map[string]string{
+				"user.go": "package models\n\ntype User struct{}\n\nfunc FilterUser() {}\n",
+			},
+			typeSpec{Type: "User"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec := test.spec
+			spec.Package = "models"
+			spec.Dir = writePackage(t, test.files)
+
+			_, _, err := generate([]typeSpec{spec})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "does not compile")
+		})
+	}
+}
+
+func TestCheckFiles(t *testing.T) {
+	dir := writePackage(t, map[string]string{})
+
+	generated, _, err := generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "a.go"},
+		{Type: "int", Package: "models", Dir: dir, Out: "b.go"},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	stale, err := checkFiles(generated, &buf)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "a.go"), filepath.Join(dir, "b.go")}, stale)
+
+	require.NoError(t, writeFiles(generated))
+
+	buf.Reset()
+	stale, err = checkFiles(generated, &buf)
+	require.NoError(t, err)
+	require.Empty(t, stale)
+	require.Empty(t, buf.String())
+
+	// a longer file on disk must be replaced, not partly overwritten
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.go"), append(generated[0].content, "// trailing\n"...), 0644))
+
+	stale, err = checkFiles(generated, &buf)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "a.go")}, stale)
+	require.Contains(t, buf.String(), "-// trailing")
+
+	require.NoError(t, writeFiles(generated))
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "a.go"))
+	require.NoError(t, err)
+	require.Equal(t, string(generated[0].content), string(content))
+}
+
+func TestGenerateGeneric(t *testing.T) {
+	dir := writePackage(t, map[string]string{})
+
+	generated, _, err := generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", Generic: true},
+		{Type: "TaggedType", Package: "models", Dir: dir, Out: "slices.go", Generic: true, Imports: []string{"github.com/jtyers/slice/customtype"}},
+		{Type: "*TaggedType", Package: "models", Dir: dir, Out: "slices.go", Generic: true, Imports: []string{"github.com/jtyers/slice/customtype"}},
+	})
+	require.NoError(t, err)
+
+	content := string(generated[0].content)
+	require.Contains(t, content, "//go:build go1.18\n")
+	require.Contains(t, content, `"github.com/jtyers/slice/generic"`)
+	require.Contains(t, content, "return generic.Filter(slice, fn)")
+
+	_, err = groupOutputs([]typeSpec{
+		{Type: "string", Out: "slices.go", Generic: true},
+		{Type: "int", Out: "slices.go"},
+	})
+	require.Error(t, err)
+}
+
+func TestGenerateIter(t *testing.T) {
+	dir := writePackage(t, map[string]string{})
+
+	generated, _, err := generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", Iter: true, Generic: true},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, generated, 2)
+
+	// the main file still builds with older versions of Go
+	content := string(generated[0].content)
+	require.Equal(t, filepath.Join(dir, "slices.go"), generated[0].file.Path)
+	require.Contains(t, content, "//go:build go1.18\n")
+	require.NotContains(t, content, "go1.23")
+	require.NotContains(t, content, "iter.Seq")
+	require.Contains(t, content, "type chainString struct {")
+
+	content = string(generated[1].content)
+	require.Equal(t, filepath.Join(dir, "slices_iter.go"), generated[1].file.Path)
+	require.Contains(t, content, "//go:build go1.23\n")
+	require.NotContains(t, content, "go1.18")
+	require.Contains(t, content, `"iter"`)
+	require.Contains(t, content, "func (c *chainString) Values() iter.Seq[string] {")
+	require.NotContains(t, content, "type chainString struct {")
+
+	generated, _, err = generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices_test.go", Iter: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "slices_iter_test.go"), generated[1].file.Path)
+
+	// no companion when every iterator is excluded
+	generated, _, err = generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", Iter: true, Exclude: ITER_METHODS},
+	})
+	require.NoError(t, err)
+	require.Len(t, generated, 1)
+
+	// left out without -iter, unless asked for
+	generated, _, err = generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go"},
+	})
+	require.NoError(t, err)
+	require.NotContains(t, string(generated[0].content), "iter.Seq")
+
+	_, _, err = generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", Methods: []string{"Values"}},
+	})
+	require.Error(t, err)
+
+	_, err = groupOutputs([]typeSpec{
+		{Type: "string", Out: "slices.go", Iter: true},
+		{Type: "int", Out: "slices.go"},
+	})
+	require.Error(t, err)
+
+	// the companion cannot overwrite another file
+	_, err = groupOutputs([]typeSpec{
+		{Type: "string", Out: "slices.go", Iter: true},
+		{Type: "int", Out: "slices_iter.go"},
+	})
+	require.Error(t, err)
+}
+
+func TestGenerateMapTo(t *testing.T) {
+	for _, generic := range []bool{false, true} {
+		dir := writePackage(t, map[string]string{})
+
+		generated, _, err := generate([]typeSpec{
+			{Type: "string", Package: "models", Dir: dir, Out: "slices.go", Generic: generic, MapTo: []string{"int", "*int", "string"}},
+			{Type: "int", Package: "models", Dir: dir, Out: "slices.go", Generic: generic},
+			{Type: "*int", Package: "models", Dir: dir, Out: "slices.go", Generic: generic},
+		})
+		require.NoError(t, err)
+
+		content := string(generated[0].content)
+		require.Contains(t, content, "func MapStringToInt(")
+		require.Contains(t, content, "func (c *chainString) ReduceToIntPtr(")
+		require.NotContains(t, content, "MapStringToString")
+	}
+
+	// targets must have chain types
+	dir := writePackage(t, map[string]string{})
+	_, _, err := generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", MapTo: []string{"int"}},
+	})
+	require.Error(t, err)
+}
+
+func TestGenerateKeyTypes(t *testing.T) {
+	dir := writePackage(t, map[string]string{})
+
+	generated, warnings, err := generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", KeyTypes: []string{"int", "bool", "[]int"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+
+	content := string(generated[0].content)
+	require.Contains(t, content, "func SortStringByKeyInt(")
+	require.Contains(t, content, "func GroupStringByBool(")
+	require.Contains(t, content, "func (c *chainString) CountByInt(")
+
+	// keys must be ordered to sort by, and comparable to group by
+	require.NotContains(t, content, "SortStringByKeyBool")
+	require.NotContains(t, content, "ByIntSlice")
+	require.NotContains(t, content, "By[]int")
+
+	_, _, err = generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", KeyTypes: []string{"Unknown"}},
+	})
+	require.Error(t, err)
+}
+
+func TestGenerateNeedsTypeLists(t *testing.T) {
+	var tests = []struct {
+		name     string
+		spec     typeSpec
+		expected string
+	}{
+		{"should reject SortByKey without key types", typeSpec{Methods: []string{"SortByKey"}}, "cannot generate SortByKey: needs -key-types with an ordered key type"},
+		{"should reject SortByKey without ordered key types", typeSpec{Methods: []string{"SortByKey"}, KeyTypes: []string{"bool"}}, "cannot generate SortByKey: needs -key-types with an ordered key type"},
+		{"should reject GroupBy without key types", typeSpec{Methods: []string{"GroupBy"}}, "cannot generate GroupBy: needs -key-types with a comparable key type"},
+		{"should reject CountBy without comparable key types", typeSpec{Methods: []string{"CountBy"}, KeyTypes: []string{"[]int"}}, "cannot generate CountBy: needs -key-types with a comparable key type"},
+		{"should reject MapTo without targets", typeSpec{Methods: []string{"MapTo"}}, "cannot generate MapTo: needs -map-to"},
+		{"should reject ReduceTo without targets", typeSpec{Methods: []string{"ReduceTo"}, MapTo: []string{"string"}}, "cannot generate ReduceTo: needs -map-to"},
+		{"should reject Unzip without types to zip with", typeSpec{Methods: []string{"Unzip"}}, "cannot generate Unzip: needs -zip-with"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := writePackage(t, map[string]string{})
+
+			spec := test.spec
+			spec.Type, spec.Package, spec.Dir, spec.Out = "string", "models", dir, "slices.go"
+			_, _, err := generate([]typeSpec{spec})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), test.expected)
+		})
+	}
+
+	// left out quietly when not asked for, along with their imports
+	dir := writePackage(t, map[string]string{})
+	generated, warnings, err := generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", Exclude: []string{"Sort", "SortStable", "SortBy", "SortStableBy", "Percentile"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+	require.NotContains(t, string(generated[0].content), `"sort"`)
+}
+
+func TestGenerateZipWith(t *testing.T) {
+	dir := writePackage(t, map[string]string{})
+
+	generated, _, err := generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", ZipWith: []string{"int", "string"}},
+		{Type: "int", Package: "models", Dir: dir, Out: "slices.go"},
+	})
+	require.NoError(t, err)
+
+	content := string(generated[0].content)
+	require.Contains(t, content, "type PairStringInt struct")
+	require.Contains(t, content, "type PairStringString struct")
+	require.Contains(t, content, "func (c *chainPairStringInt) Unzip() (*chainString, *chainInt)")
+
+	// Unzip needs the other type's chain
+	_, _, err = generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", ZipWith: []string{"int"}},
+	})
+	require.Error(t, err)
+
+	// but Zip alone does not
+	_, _, err = generate([]typeSpec{
+		{Type: "string", Package: "models", Dir: dir, Out: "slices.go", ZipWith: []string{"int"}, Exclude: []string{"Unzip"}},
+	})
+	require.NoError(t, err)
+}
+
+func TestGenerateFlattenDeep(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"models.go": `package models
+
+import "time"
+
+type Names []string
+
+type Table []Names
+
+type Times []time.Time
+`,
+	})
+
+	generated, _, err := generate([]typeSpec{
+		{Type: "Table", Package: "models", Dir: dir, Out: "slices.go"},
+		{Type: "Times", Package: "models", Dir: dir, Out: "slices.go", Methods: []string{"Flatten"}},
+	})
+	require.NoError(t, err)
+
+	content := string(generated[0].content)
+	require.Contains(t, content, "func FlattenDeepTable(slice []Table) (res []string)")
+	require.Contains(t, content, "func FlattenTimes(")
+
+	// types from other packages cannot be written without importing them
+	_, _, err = generate([]typeSpec{
+		{Type: "Times", Package: "models", Dir: dir, Out: "slices.go", Methods: []string{"FlattenDeep"}},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+//go:build go1.18
+// +build go1.18
+
+package generic
+
+// Chain runs slice operations one after the other. Each returns a new Chain,
+// leaving the original unchanged.
+type Chain[T any] struct {
+	value []T
+}
+
+// New starts a Chain on slice.
+func New[T any](slice []T) *Chain[T] {
+	return &Chain[T]{value: slice}
+}
+
+// Value returns the result of the chain.
+func (c *Chain[T]) Value() []T {
+	return c.value
+}
+
+func (c *Chain[T]) Concat(slice2 []T) *Chain[T] {
+	return &Chain[T]{value: Concat(c.value, slice2)}
+}
+
+func (c *Chain[T]) Drop(n int) *Chain[T] {
+	return &Chain[T]{value: Drop(c.value, n)}
+}
+
+func (c *Chain[T]) DropRight(n int) *Chain[T] {
+	return &Chain[T]{value: DropRight(c.value, n)}
+}
+
+func (c *Chain[T]) Filter(fn func(T, int) bool) *Chain[T] {
+	return &Chain[T]{value: Filter(c.value, fn)}
+}
+
+func (c *Chain[T]) First() *Chain[T] {
+	return &Chain[T]{value: []T{First(c.value)}}
+}
+
+func (c *Chain[T]) Last() *Chain[T] {
+	return &Chain[T]{value: []T{Last(c.value)}}
+}
+
+func (c *Chain[T]) Map(fn func(T, int) T) *Chain[T] {
+	return &Chain[T]{value: Map(c.value, fn)}
+}
+
+func (c *Chain[T]) Reduce(fn func(T, T, int) T, initial T) *Chain[T] {
+	return &Chain[T]{value: []T{Reduce(c.value, fn, initial)}}
+}
+
+func (c *Chain[T]) Reverse() *Chain[T] {
+	return &Chain[T]{value: Reverse(c.value)}
+}
+
+// MapTo maps a Chain of one type to a Chain of another, which Chain's own
+// methods cannot as methods may not have type parameters.
+func MapTo[T, U any](c *Chain[T], fn func(T, int) U) *Chain[U] {
+	return &Chain[U]{value: Map(c.value, fn)}
+}
+
+// ReduceTo reduces a Chain to a single value of another type.
+func ReduceTo[T, A any](c *Chain[T], fn func(A, T, int) A, initial A) A {
+	return Reduce(c.value, fn, initial)
+}
@@ -0,0 +1,144 @@
+//go:build go1.18
+// +build go1.18
+
+// Package generic is the type-parameterised core behind code generated with
+// -generic. Generated functions for each type are thin wrappers around these,
+// and they may also be used directly.
+package generic
+
+// Concat returns a new slice of slice followed by slice2.
+func Concat[T any](slice []T, slice2 []T) (res []T) {
+	res = make([]T, 0, len(slice)+len(slice2))
+	res = append(res, slice...)
+	res = append(res, slice2...)
+	return
+}
+
+// Contains returns true if item is in slice, compared with ==.
+func Contains[T comparable](slice []T, item T) bool {
+	for _, val := range slice {
+		if val == item {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsFunc returns true if eq returns true for item and any entry in
+// slice.
+func ContainsFunc[T any](slice []T, item T, eq func(T, T) bool) bool {
+	for _, val := range slice {
+		if eq(val, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Drop returns a new slice with n entries dropped from the start.
+func Drop[T any](slice []T, n int) (res []T) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]T, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+// DropRight returns a new slice with n entries dropped from the end.
+func DropRight[T any](slice []T, n int) (res []T) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]T, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+// Filter returns a new slice of the entries fn returns true for.
+func Filter[T any](slice []T, fn func(T, int) bool) (res []T) {
+	res = make([]T, 0, len(slice))
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+// First returns the first entry in slice, or the zero value if it is empty.
+func First[T any](slice []T) (res T) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	return
+}
+
+// Last returns the last entry in slice, or the zero value if it is empty.
+func Last[T any](slice []T) (res T) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[len(slice)-1]
+	return
+}
+
+// Map returns a new slice of the results of fn for each entry.
+func Map[T, U any](slice []T, fn func(T, int) U) (res []U) {
+	res = make([]U, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+// Reduce accumulates the result of fn for each entry, starting with initial.
+func Reduce[T, A any](slice []T, fn func(A, T, int) A, initial A) (res A) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+// Reverse returns a new slice in reverse order.
+func Reverse[T any](slice []T) (res []T) {
+	res = make([]T, len(slice))
+	for index, entry := range slice {
+		res[len(slice)-1-index] = entry
+	}
+	return
+}
+
+// Uniq returns a new slice without duplicates, compared with ==.
+func Uniq[T comparable](slice []T) (res []T) {
+	seen := make(map[T]bool)
+	res = []T{}
+	for _, entry := range slice {
+		if _, found := seen[entry]; !found {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+// UniqFunc returns a new slice without duplicates, compared with eq.
+func UniqFunc[T any](slice []T, eq func(T, T) bool) (res []T) {
+	res = []T{}
+	for _, entry := range slice {
+		if !ContainsFunc(res, entry, eq) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
@@ -0,0 +1,125 @@
+//go:build go1.18
+// +build go1.18
+
+package generic
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcat(t *testing.T) {
+	require.Equal(t, []int{1, 2, 3, 4}, Concat([]int{1, 2}, []int{3, 4}))
+}
+
+func TestContains(t *testing.T) {
+	require.True(t, Contains([]string{"a", "b"}, "b"))
+	require.False(t, Contains([]string{"a", "b"}, "c"))
+}
+
+func TestContainsFunc(t *testing.T) {
+	eq := func(a, b []int) bool { return len(a) == len(b) }
+
+	require.True(t, ContainsFunc([][]int{{1}, {1, 2}}, []int{3, 4}, eq))
+	require.False(t, ContainsFunc([][]int{{1}, {1, 2}}, []int{}, eq))
+}
+
+func TestDrop(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []int
+		n      int
+		output []int
+	}{
+		{"should drop from the start", []int{1, 2, 3}, 2, []int{3}},
+		{"should drop everything if n is too large", []int{1, 2, 3}, 5, []int{}},
+		{"should drop nothing if n is negative", []int{1, 2, 3}, -1, []int{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.output, Drop(test.input, test.n))
+		})
+	}
+}
+
+func TestDropRight(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []int
+		n      int
+		output []int
+	}{
+		{"should drop from the end", []int{1, 2, 3}, 2, []int{1}},
+		{"should drop everything if n is too large", []int{1, 2, 3}, 5, []int{}},
+		{"should drop nothing if n is negative", []int{1, 2, 3}, -1, []int{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.output, DropRight(test.input, test.n))
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	even := func(i int, index int) bool { return i%2 == 0 }
+
+	require.Equal(t, []int{2, 4}, Filter([]int{1, 2, 3, 4}, even))
+}
+
+func TestFirstLast(t *testing.T) {
+	require.Equal(t, 1, First([]int{1, 2, 3}))
+	require.Equal(t, 3, Last([]int{1, 2, 3}))
+	require.Equal(t, "", First([]string{}))
+	require.Equal(t, "", Last([]string{}))
+}
+
+func TestMap(t *testing.T) {
+	got := Map([]int{1, 2, 3}, func(i int, index int) string { return strconv.Itoa(i * 2) })
+
+	require.Equal(t, []string{"2", "4", "6"}, got)
+}
+
+func TestReduce(t *testing.T) {
+	got := Reduce([]string{"a", "bb", "ccc"}, func(acc int, s string, index int) int { return acc + len(s) }, 1)
+
+	require.Equal(t, 7, got)
+}
+
+func TestReverse(t *testing.T) {
+	require.Equal(t, []int{3, 2, 1}, Reverse([]int{1, 2, 3}))
+}
+
+func TestUniq(t *testing.T) {
+	require.Equal(t, []int{1, 2, 3}, Uniq([]int{1, 2, 1, 3, 3}))
+}
+
+func TestUniqFunc(t *testing.T) {
+	eq := func(a, b []int) bool { return len(a) == len(b) }
+
+	require.Equal(t, [][]int{{1}, {1, 2}}, UniqFunc([][]int{{1}, {1, 2}, {3}, {4, 5}}, eq))
+}
+
+func TestChain(t *testing.T) {
+	c := New([]int{1, 2, 3, 4, 5}).
+		Filter(func(i int, index int) bool { return i > 1 }).
+		Map(func(i int, index int) int { return i * 10 }).
+		Drop(1).
+		DropRight(1).
+		Concat([]int{60}).
+		Reverse()
+
+	require.Equal(t, []int{60, 40, 30}, c.Value())
+	require.Equal(t, []int{60}, c.First().Value())
+	require.Equal(t, []int{30}, c.Last().Value())
+	require.Equal(t, []int{131}, c.Reduce(func(acc int, i int, index int) int { return acc + i }, 1).Value())
+
+	strings := MapTo(c, func(i int, index int) string { return strconv.Itoa(i) })
+	require.Equal(t, []string{"60", "40", "30"}, strings.Value())
+
+	total := ReduceTo(strings, func(acc int, s string, index int) int { return acc + len(s) }, 0)
+	require.Equal(t, 6, total)
+}
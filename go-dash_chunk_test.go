@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntChunk(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []int
+		size   int
+		output [][]int
+	}{
+		{"should split into chunks", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"should leave a short last chunk", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"should make one chunk if size is too large", []int{1, 2, 3}, 5, [][]int{{1, 2, 3}}},
+		{"should make no chunks if size is zero", []int{1, 2, 3}, 0, [][]int{}},
+		{"should make no chunks from an empty slice", []int{}, 2, [][]int{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.output, ChunkInt(test.input, test.size))
+			require.Equal(t, test.output, NewIntSlice(test.input).Chunk(test.size).Value())
+		})
+	}
+}
+
+func TestIntWindow(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []int
+		size   int
+		step   int
+		output [][]int
+	}{
+		{"should slide by one", []int{1, 2, 3, 4}, 2, 1, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{"should slide by step", []int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}},
+		{"should skip elements if step is larger than size", []int{1, 2, 3, 4, 5}, 1, 3, [][]int{{1}, {4}}},
+		{"should make no windows if size is too large", []int{1, 2, 3}, 4, 1, [][]int{}},
+		{"should make no windows if step is zero", []int{1, 2, 3}, 2, 0, [][]int{}},
+		{"should make no windows from an empty slice", []int{}, 1, 1, [][]int{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.output, WindowInt(test.input, test.size, test.step))
+			require.Equal(t, test.output, NewIntSlice(test.input).Window(test.size, test.step).Value())
+		})
+	}
+}
+
+func TestIntWindowCopies(t *testing.T) {
+	windows := WindowInt([]int{1, 2, 3}, 2, 1)
+	windows[0][1] = 9
+	require.Equal(t, []int{2, 3}, windows[1])
+}
+
+func TestIntSlicesChain(t *testing.T) {
+	sum := func(acc int, i int, index int) int { return acc + i }
+
+	// moving sums
+	require.Equal(t, []int{3, 5, 7}, NewIntSlice([]int{1, 2, 3, 4}).Window(2, 1).Reduce(sum, 0).Value())
+
+	doubled := NewIntSlices([][]int{{1}, {2, 3}}).Map(func(chunk []int, index int) []int {
+		return MapInt(chunk, func(i int, index int) int { return i * 2 })
+	})
+	require.Equal(t, [][]int{{2}, {4, 6}}, doubled.Value())
+
+	indexes := []int{}
+	lengths := []int{}
+	NewIntSlice([]int{1, 2, 3}).Chunk(2).Each(func(chunk []int, index int) {
+		indexes = append(indexes, index)
+		lengths = append(lengths, len(chunk))
+	})
+	require.Equal(t, []int{0, 1}, indexes)
+	require.Equal(t, []int{2, 1}, lengths)
+}
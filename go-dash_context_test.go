@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntMapCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	fn := func(i int, index int) (int, error) {
+		calls++
+		if i == 2 {
+			cancel()
+		}
+		return i * 2, nil
+	}
+
+	res, err := MapCtxInt(context.Background(), []int{1, 2, 3}, func(i int, index int) (int, error) { return i * 2, nil })
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 4, 6}, res)
+
+	res, err = MapCtxInt(ctx, []int{1, 2, 3}, fn)
+	require.Equal(t, context.Canceled, err)
+	require.Nil(t, res)
+	require.Equal(t, 2, calls)
+}
+
+func TestIntFilterReduceForEachCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	even := func(i int, index int) (bool, error) { return i%2 == 0, nil }
+	sum := func(acc int, i int, index int) (int, error) { return acc + i, nil }
+	none := func(i int, index int) error { return nil }
+
+	res, err := FilterCtxInt(context.Background(), []int{1, 2, 3, 4}, even)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 4}, res)
+	_, err = FilterCtxInt(ctx, []int{1, 2, 3, 4}, even)
+	require.Equal(t, context.Canceled, err)
+
+	total, err := ReduceCtxInt(context.Background(), []int{1, 2, 3}, sum, 0)
+	require.NoError(t, err)
+	require.Equal(t, 6, total)
+	_, err = ReduceCtxInt(ctx, []int{1, 2, 3}, sum, 0)
+	require.Equal(t, context.Canceled, err)
+
+	require.NoError(t, ForEachCtxInt(context.Background(), []int{1, 2, 3}, none))
+	require.Equal(t, context.Canceled, ForEachCtxInt(ctx, []int{1, 2, 3}, none))
+
+	// an empty slice never checks the context
+	require.NoError(t, ForEachCtxInt(ctx, []int{}, none))
+}
+
+func TestIntParallelCtx(t *testing.T) {
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+	double := func(i int, index int) (int, error) { return i * 2, nil }
+
+	res, err := ParallelMapCtxInt(context.Background(), input, double, 4)
+	require.NoError(t, err)
+	expected, _ := MapErrInt(input, double)
+	require.Equal(t, expected, res)
+
+	filtered, err := ParallelFilterCtxInt(context.Background(), input, func(i int, index int) (bool, error) { return i < 3, nil }, 4)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2}, filtered)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls int64
+	err = ParallelForEachCtxInt(ctx, input, func(i int, index int) error {
+		if atomic.AddInt64(&calls, 1) == 10 {
+			cancel()
+		}
+		return nil
+	}, 4)
+	require.Equal(t, context.Canceled, err)
+	// workers already past their check may finish their element
+	require.Less(t, atomic.LoadInt64(&calls), int64(20))
+
+	res, err = ParallelMapCtxInt(ctx, input, double, 4)
+	require.Equal(t, context.Canceled, err)
+	require.Nil(t, res)
+
+	_, err = ParallelMapCtxInt(context.Background(), input, func(i int, index int) (int, error) {
+		if i == 500 {
+			return 0, errTest
+		}
+		return i, nil
+	}, 4)
+	require.Equal(t, errTest, err)
+}
+
+func TestStringChainCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	suffix := func(s string, index int) (string, error) { return s + strconv.Itoa(index), nil }
+	keep := func(s string, index int) (bool, error) { return s != "b1", nil }
+
+	res, err := NewStringSlice([]string{"a", "b", "c"}).MapCtx(ctx, suffix).FilterCtx(ctx, keep).Reverse().Result()
+	require.NoError(t, err)
+	require.Equal(t, []string{"c2", "a0"}, res)
+
+	res, err = NewStringSlice([]string{"a", "b", "c"}).Parallel(2).MapCtx(ctx, suffix).FilterCtx(ctx, keep).Result()
+	require.NoError(t, err)
+	require.Equal(t, []string{"a0", "c2"}, res)
+
+	cancel()
+
+	res, err = NewStringSlice([]string{"a", "b", "c"}).MapCtx(ctx, suffix).Result()
+	require.Equal(t, context.Canceled, err)
+	require.Nil(t, res)
+
+	res, err = NewStringSlice([]string{"a", "b", "c"}).Parallel(2).FilterCtx(ctx, keep).Sequential().Result()
+	require.Equal(t, context.Canceled, err)
+	require.Nil(t, res)
+
+	res, err = NewStringSlice([]string{"a", "b", "c"}).Parallel(2).MapCtx(ctx, suffix).Result()
+	require.Equal(t, context.Canceled, err)
+	require.Nil(t, res)
+
+	// errors from earlier in the chain take precedence
+	res, err = NewStringSlice([]string{"a"}).MapErr(func(s string, index int) (string, error) {
+		return s, errTest
+	}).ReduceCtx(ctx, func(acc string, s string, index int) (string, error) { return acc + s, nil }, "").Result()
+	require.Equal(t, errTest, err)
+	require.Nil(t, res)
+
+	require.Equal(t, context.Canceled, NewStringSlice([]string{"a"}).ForEachCtx(ctx, func(s string, index int) error { return nil }))
+	require.Equal(t, context.Canceled, NewStringSlice([]string{"a"}).Parallel(2).ForEachCtx(ctx, func(s string, index int) error { return nil }))
+}
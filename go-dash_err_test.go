@@ -0,0 +1,186 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errTest = errors.New("test error")
+
+func TestStringMapErr(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    []string
+		expected []string
+		calls    int
+		err      error
+	}{
+		{"should map every entry", []string{"a", "b"}, []string{"a0", "b1"}, 2, nil},
+		{"should stop at the first error", []string{"a", "", "c"}, nil, 2, errTest},
+		{"should map an empty slice", []string{}, []string{}, 0, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls := 0
+			res, err := MapErrString(test.input, func(s string, index int) (string, error) {
+				calls++
+				if s == "" {
+					return "", errTest
+				}
+				return s + strconv.Itoa(index), nil
+			})
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.expected, res)
+			require.Equal(t, test.calls, calls)
+		})
+	}
+}
+
+func TestStringFilterErr(t *testing.T) {
+	fn := func(s string, index int) (bool, error) {
+		if s == "" {
+			return false, errTest
+		}
+		return s != "b", nil
+	}
+
+	res, err := FilterErrString([]string{"a", "b", "c"}, fn)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c"}, res)
+
+	res, err = FilterErrString([]string{"a", "", "c"}, fn)
+	require.Equal(t, errTest, err)
+	require.Nil(t, res)
+}
+
+func TestIntReduceErr(t *testing.T) {
+	fn := func(acc int, i int, index int) (int, error) {
+		if i < 0 {
+			return acc, errTest
+		}
+		return acc + i, nil
+	}
+
+	res, err := ReduceErrInt([]int{1, 2, 3}, fn, 10)
+	require.NoError(t, err)
+	require.Equal(t, 16, res)
+
+	res, err = ReduceErrInt([]int{1, -2, 3}, fn, 10)
+	require.Equal(t, errTest, err)
+	require.Equal(t, 0, res)
+}
+
+func TestIntForEachErr(t *testing.T) {
+	seen := []int{}
+	err := ForEachErrInt([]int{1, 2, 3}, func(i int, index int) error {
+		if i == 2 {
+			return errTest
+		}
+		seen = append(seen, i)
+		return nil
+	})
+	require.Equal(t, errTest, err)
+	require.Equal(t, []int{1}, seen)
+
+	require.NoError(t, ForEachErrInt([]int{1, 2, 3}, func(i int, index int) error { return nil }))
+}
+
+func TestIntChainErr(t *testing.T) {
+	parse := func(i int, index int) (int, error) {
+		if i < 0 {
+			return 0, errTest
+		}
+		return i * 2, nil
+	}
+	calls := 0
+	big := func(i int, index int) bool {
+		calls++
+		return i > 2
+	}
+
+	res, err := NewIntSlice([]int{1, 2, 3}).MapErr(parse).Filter(big).Reverse().Result()
+	require.NoError(t, err)
+	require.Equal(t, []int{6, 4}, res)
+
+	calls = 0
+	chain := NewIntSlice([]int{1, -2, 3}).MapErr(parse).Filter(big).Reverse()
+	res, err = chain.Result()
+	require.Equal(t, errTest, err)
+	require.Nil(t, res)
+	require.Equal(t, 0, calls)
+
+	// later Err methods do not run, and keep the first error
+	res, err = chain.FilterErr(func(i int, index int) (bool, error) {
+		calls++
+		return true, errors.New("other")
+	}).ReduceErr(func(acc int, i int, index int) (int, error) {
+		calls++
+		return acc, nil
+	}, 0).Result()
+	require.Equal(t, errTest, err)
+	require.Nil(t, res)
+	require.Equal(t, 0, calls)
+	require.Equal(t, errTest, chain.ForEachErr(func(i int, index int) error { return nil }))
+
+	res, err = NewIntSlice([]int{1, 2, 3}).ReduceErr(func(acc int, i int, index int) (int, error) {
+		return acc + i, nil
+	}, 0).Result()
+	require.NoError(t, err)
+	require.Equal(t, []int{6}, res)
+
+	// kept through other kinds of chain
+	_, err = NewIntSlice([]int{-1}).MapErr(parse).Lazy().Take(1).Eager().Parallel(2).Sequential().MapToString(func(i int, index int) string { return "" }).Result()
+	require.Equal(t, errTest, err)
+}
+
+func TestChainErrKept(t *testing.T) {
+	fail := func(s string, index int) (string, error) { return s, errTest }
+	failInt := func(i int, index int) (int, error) { return i, errTest }
+	key := func(s string) int { return len(s) }
+	sum := func(acc string, s string, index int) string { return acc + s }
+	same := func(s []string, index int) []string { return s }
+	any := func(p PairIntString, index int) bool { return true }
+
+	var tests = []struct {
+		name   string
+		result func() error
+	}{
+		{"should keep errors through SortByKey", func() error {
+			_, err := NewStringSlice([]string{"a"}).MapErr(fail).SortByKeyInt(key).Result()
+			return err
+		}},
+		{"should keep errors through Chunk and Flatten", func() error {
+			_, err := NewStringSlice([]string{"a"}).MapErr(fail).Chunk(1).Flatten().Result()
+			return err
+		}},
+		{"should keep errors through Window and Map", func() error {
+			_, err := NewStringSlice([]string{"a"}).MapErr(fail).Window(1, 1).Map(same).Result()
+			return err
+		}},
+		{"should keep errors through the Reduce of slices", func() error {
+			_, err := NewStringSlice([]string{"a"}).MapErr(fail).Chunk(1).Reduce(sum, "").Result()
+			return err
+		}},
+		{"should keep errors through Zip and Filter", func() error {
+			_, err := NewIntSlice([]int{1}).MapErr(failInt).ZipString([]string{"a"}).Filter(any).Result()
+			return err
+		}},
+		{"should keep errors through Unzip", func() error {
+			ints, strs := NewIntSlice([]int{1}).MapErr(failInt).ZipString([]string{"a"}).Unzip()
+			_, err := ints.Result()
+			require.Equal(t, errTest, err)
+			_, err = strs.Result()
+			return err
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, errTest, test.result())
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"testing"
+
+	. "github.com/jtyers/slice/customtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringFlatten(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  [][]string
+		output []string
+	}{
+		{"should join slices in order", [][]string{{"a", "b"}, {}, {"c"}}, []string{"a", "b", "c"}},
+		{"should flatten a single slice", [][]string{{"a"}}, []string{"a"}},
+		{"should flatten no slices", [][]string{}, []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.output, FlattenString(test.input))
+			require.Equal(t, test.output, NewStringSlices(test.input).Flatten().Value())
+		})
+	}
+}
+
+func TestStringChunkFlatten(t *testing.T) {
+	input := []string{"a", "b", "c", "d", "e"}
+	require.Equal(t, input, NewStringSlice(input).Chunk(2).Flatten().Value())
+}
+
+func TestStringFlatMap(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []string
+		fn     func(string, int) []string
+		output []string
+	}{
+		{
+			"should expand each element",
+			[]string{"a", "b"},
+			func(s string, i int) []string { return []string{s, s + s} },
+			[]string{"a", "aa", "b", "bb"},
+		},
+		{
+			"should drop elements mapped to nothing",
+			[]string{"a", "b", "c"},
+			func(s string, i int) []string {
+				if i == 1 {
+					return nil
+				}
+				return []string{s}
+			},
+			[]string{"a", "c"},
+		},
+		{
+			"should map an empty slice",
+			[]string{},
+			func(s string, i int) []string { return []string{s} },
+			[]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.output, FlatMapString(test.input, test.fn))
+			require.Equal(t, test.output, NewStringSlice(test.input).FlatMap(test.fn).Value())
+		})
+	}
+}
+
+func TestFlattenDeep(t *testing.T) {
+	require.Equal(t, []int{1, 2, 3}, FlattenDeepRow([]Row{{1, 2}, {}, {3}}))
+	require.Equal(t, []int{1, 2, 3}, NewRowSlice([]Row{{1}, {2, 3}}).FlattenDeep())
+
+	grids := []Grid{{{1, 2}, {3}}, {}, {{4}}}
+	require.Equal(t, []int{1, 2, 3, 4}, FlattenDeepGrid(grids))
+	require.Equal(t, []int{}, FlattenDeepGrid([]Grid{}))
+
+	// Flatten only goes one level down
+	require.Equal(t, []Row{{1}, {2}}, FlattenRow([][]Row{{{1}}, {{2}}}))
+}
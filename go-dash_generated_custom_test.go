@@ -1,24 +1,25 @@
 // This code is generated by https://github.com/jtyers/slice
 // DO NOT EDIT!
 
-
-
 package main
 
-
 import (
-  . "github.com/jtyers/slice/customtype"
+	"context"
+	. "github.com/jtyers/slice/customtype"
+	"sort"
+	"sync"
+	"sync/atomic"
 )
 
 type chainCustomType struct {
-  isPtr bool
+	isPtr bool
 	value []CustomType
+	err   error
 }
 
 func NewCustomTypeSlice(slice []CustomType) *chainCustomType {
 	return &chainCustomType{
 		value: slice,
-		
 	}
 }
 
@@ -26,8 +27,18 @@ func (c *chainCustomType) Value() []CustomType {
 	return c.value
 }
 
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainCustomType) Result() ([]CustomType, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
 func ConcatCustomType(slice []CustomType, slice2 []CustomType) (res []CustomType) {
-	res = make([]CustomType, 0, len(slice) + len(slice2))
+	res = make([]CustomType, 0, len(slice)+len(slice2))
 	for _, entry := range slice {
 		res = append(res, entry)
 	}
@@ -38,7 +49,7 @@ func ConcatCustomType(slice []CustomType, slice2 []CustomType) (res []CustomType
 }
 
 func (c *chainCustomType) Concat(slice2 []CustomType) *chainCustomType {
-	return &chainCustomType{value: ConcatCustomType(c.value, slice2)}
+	return &chainCustomType{value: ConcatCustomType(c.value, slice2), err: c.err}
 }
 
 func ContainsCustomType(slice []CustomType, item CustomType) (res bool) {
@@ -46,7 +57,7 @@ func ContainsCustomType(slice []CustomType, item CustomType) (res bool) {
 		if val == item {
 			return true
 		}
-		
+
 	}
 	return false
 }
@@ -60,15 +71,18 @@ func DropCustomType(slice []CustomType, n int) (res []CustomType) {
 	if l < 0 {
 		l = 0
 	}
+	if l > len(slice) {
+		l = len(slice)
+	}
 	res = make([]CustomType, 0, l)
-	for _, entry := range slice[len(slice) - l:] {
+	for _, entry := range slice[len(slice)-l:] {
 		res = append(res, entry)
 	}
 	return
 }
 
 func (c *chainCustomType) Drop(n int) *chainCustomType {
-	return &chainCustomType{value: DropCustomType(c.value, n)}
+	return &chainCustomType{value: DropCustomType(c.value, n), err: c.err}
 }
 
 func DropRightCustomType(slice []CustomType, n int) (res []CustomType) {
@@ -76,6 +90,9 @@ func DropRightCustomType(slice []CustomType, n int) (res []CustomType) {
 	if l < 0 {
 		l = 0
 	}
+	if l > len(slice) {
+		l = len(slice)
+	}
 	res = make([]CustomType, 0, l)
 	for _, entry := range slice[:l] {
 		res = append(res, entry)
@@ -84,10 +101,10 @@ func DropRightCustomType(slice []CustomType, n int) (res []CustomType) {
 }
 
 func (c *chainCustomType) DropRight(n int) *chainCustomType {
-	return &chainCustomType{value: DropRightCustomType(c.value, n)}
+	return &chainCustomType{value: DropRightCustomType(c.value, n), err: c.err}
 }
 
-func FilterCustomType(slice []CustomType, fn func(CustomType,int)bool) (res []CustomType) {
+func FilterCustomType(slice []CustomType, fn func(CustomType, int) bool) (res []CustomType) {
 	res = make([]CustomType, 0, len(slice))
 	for index, entry := range slice {
 		if fn(entry, index) {
@@ -97,8 +114,8 @@ func FilterCustomType(slice []CustomType, fn func(CustomType,int)bool) (res []Cu
 	return
 }
 
-func (c *chainCustomType) Filter(fn func(CustomType,int)bool) *chainCustomType {
-	return &chainCustomType{value: FilterCustomType(c.value, fn)}
+func (c *chainCustomType) Filter(fn func(CustomType, int) bool) *chainCustomType {
+	return &chainCustomType{value: FilterCustomType(c.value, fn), err: c.err}
 }
 
 func FirstCustomType(slice []CustomType) (res CustomType) {
@@ -110,22 +127,22 @@ func FirstCustomType(slice []CustomType) (res CustomType) {
 }
 
 func (c *chainCustomType) First() *chainCustomType {
-	return &chainCustomType{value: []CustomType{FirstCustomType(c.value)}}
+	return &chainCustomType{value: []CustomType{FirstCustomType(c.value)}, err: c.err}
 }
 
 func LastCustomType(slice []CustomType) (res CustomType) {
 	if len(slice) == 0 {
 		return
 	}
-	res = slice[len(slice) - 1]
+	res = slice[len(slice)-1]
 	return
 }
 
 func (c *chainCustomType) Last() *chainCustomType {
-	return &chainCustomType{value: []CustomType{LastCustomType(c.value)}}
+	return &chainCustomType{value: []CustomType{LastCustomType(c.value)}, err: c.err}
 }
 
-func MapCustomType(slice []CustomType, fn func(CustomType,int)CustomType) (res []CustomType) {
+func MapCustomType(slice []CustomType, fn func(CustomType, int) CustomType) (res []CustomType) {
 	res = make([]CustomType, 0, len(slice))
 	for index, entry := range slice {
 		res = append(res, fn(entry, index))
@@ -133,12 +150,11 @@ func MapCustomType(slice []CustomType, fn func(CustomType,int)CustomType) (res [
 	return
 }
 
-func (c *chainCustomType) Map(fn func(CustomType,int)CustomType) *chainCustomType {
-	return &chainCustomType{value: MapCustomType(c.value, fn)}
+func (c *chainCustomType) Map(fn func(CustomType, int) CustomType) *chainCustomType {
+	return &chainCustomType{value: MapCustomType(c.value, fn), err: c.err}
 }
 
-
-func ReduceCustomType(slice []CustomType, fn func(CustomType,CustomType,int)CustomType, initial CustomType) (res CustomType) {
+func ReduceCustomType(slice []CustomType, fn func(CustomType, CustomType, int) CustomType, initial CustomType) (res CustomType) {
 	res = initial
 	for index, entry := range slice {
 		res = fn(res, entry, index)
@@ -146,8 +162,8 @@ func ReduceCustomType(slice []CustomType, fn func(CustomType,CustomType,int)Cust
 	return
 }
 
-func (c *chainCustomType) Reduce(fn func(CustomType,CustomType,int)CustomType, initial CustomType) *chainCustomType {
-	return &chainCustomType{value: []CustomType{ReduceCustomType(c.value, fn, initial)}}
+func (c *chainCustomType) Reduce(fn func(CustomType, CustomType, int) CustomType, initial CustomType) *chainCustomType {
+	return &chainCustomType{value: []CustomType{ReduceCustomType(c.value, fn, initial)}, err: c.err}
 }
 
 func ReverseCustomType(slice []CustomType) (res []CustomType) {
@@ -159,7 +175,7 @@ func ReverseCustomType(slice []CustomType) (res []CustomType) {
 }
 
 func (c *chainCustomType) Reverse() *chainCustomType {
-	return &chainCustomType{value: ReverseCustomType(c.value)}
+	return &chainCustomType{value: ReverseCustomType(c.value), err: c.err}
 }
 
 func UniqCustomType(slice []CustomType) (res []CustomType) {
@@ -172,11 +188,1333 @@ func UniqCustomType(slice []CustomType) (res []CustomType) {
 		}
 	}
 	return
+
 }
 
 func (c *chainCustomType) Uniq() *chainCustomType {
 	if c.isPtr {
 		panic("Uniq() does not currently support pointers")
 	}
-	return &chainCustomType{value: UniqCustomType(c.value)}
+	return &chainCustomType{value: UniqCustomType(c.value), err: c.err}
+}
+
+func SortCustomType(slice []CustomType) (res []CustomType) {
+	res = make([]CustomType, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Less(res[j])
+	})
+	return
+}
+
+func (c *chainCustomType) Sort() *chainCustomType {
+	return &chainCustomType{value: SortCustomType(c.value), err: c.err}
+}
+
+func SortStableCustomType(slice []CustomType) (res []CustomType) {
+	res = make([]CustomType, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Less(res[j])
+	})
+	return
+}
+
+func (c *chainCustomType) SortStable() *chainCustomType {
+	return &chainCustomType{value: SortStableCustomType(c.value), err: c.err}
+}
+
+func IsSortedCustomType(slice []CustomType) bool {
+	for index := 1; index < len(slice); index++ {
+		if slice[index].Less(slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCustomType) IsSorted() bool {
+	return IsSortedCustomType(c.value)
+}
+
+func SortByCustomType(slice []CustomType, less func(a, b CustomType) bool) (res []CustomType) {
+	res = make([]CustomType, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainCustomType) SortBy(less func(a, b CustomType) bool) *chainCustomType {
+	return &chainCustomType{value: SortByCustomType(c.value, less), err: c.err}
+}
+
+func SortStableByCustomType(slice []CustomType, less func(a, b CustomType) bool) (res []CustomType) {
+	res = make([]CustomType, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainCustomType) SortStableBy(less func(a, b CustomType) bool) *chainCustomType {
+	return &chainCustomType{value: SortStableByCustomType(c.value, less), err: c.err}
+}
+
+func IsSortedByCustomType(slice []CustomType, less func(a, b CustomType) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCustomType) IsSortedBy(less func(a, b CustomType) bool) bool {
+	return IsSortedByCustomType(c.value, less)
+}
+
+func SortCustomTypeByKeyString(slice []CustomType, key func(CustomType) string) (res []CustomType) {
+	keys := make([]string, len(slice))
+	order := make([]int, len(slice))
+	for index, entry := range slice {
+		keys[index] = key(entry)
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return keys[order[i]] < keys[order[j]]
+	})
+	res = make([]CustomType, len(slice))
+	for index, from := range order {
+		res[index] = slice[from]
+	}
+	return
+}
+
+func (c *chainCustomType) SortByKeyString(key func(CustomType) string) *chainCustomType {
+	return &chainCustomType{value: SortCustomTypeByKeyString(c.value, key), err: c.err}
+}
+
+func UnionCustomType(slice []CustomType, slice2 []CustomType) (res []CustomType) {
+	res = []CustomType{}
+	seen := make(map[CustomType]bool)
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) Union(slice2 []CustomType) *chainCustomType {
+	return &chainCustomType{value: UnionCustomType(c.value, slice2), err: c.err}
+}
+
+func IntersectionCustomType(slice []CustomType, slice2 []CustomType) (res []CustomType) {
+	res = []CustomType{}
+	in := make(map[CustomType]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	seen := make(map[CustomType]bool)
+	for _, entry := range slice {
+		if in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) Intersection(slice2 []CustomType) *chainCustomType {
+	return &chainCustomType{value: IntersectionCustomType(c.value, slice2), err: c.err}
+}
+
+func DifferenceCustomType(slice []CustomType, slice2 []CustomType) (res []CustomType) {
+	res = []CustomType{}
+	seen := make(map[CustomType]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) Difference(slice2 []CustomType) *chainCustomType {
+	return &chainCustomType{value: DifferenceCustomType(c.value, slice2), err: c.err}
+}
+
+func XorCustomType(slice []CustomType, slice2 []CustomType) (res []CustomType) {
+	res = []CustomType{}
+	in := make(map[CustomType]bool, len(slice))
+	for _, entry := range slice {
+		in[entry] = true
+	}
+	in2 := make(map[CustomType]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[entry] = true
+	}
+	seen := make(map[CustomType]bool)
+	for _, entry := range slice {
+		if !in2[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) Xor(slice2 []CustomType) *chainCustomType {
+	return &chainCustomType{value: XorCustomType(c.value, slice2), err: c.err}
+}
+
+func IsSubsetCustomType(slice []CustomType, slice2 []CustomType) bool {
+	in := make(map[CustomType]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if !in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCustomType) IsSubset(slice2 []CustomType) bool {
+	return IsSubsetCustomType(c.value, slice2)
+}
+
+func IsDisjointCustomType(slice []CustomType, slice2 []CustomType) bool {
+	in := make(map[CustomType]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCustomType) IsDisjoint(slice2 []CustomType) bool {
+	return IsDisjointCustomType(c.value, slice2)
+}
+
+func GroupCustomTypeByString(slice []CustomType, fn func(CustomType) string) (res map[string][]CustomType) {
+	res = make(map[string][]CustomType)
+	for _, entry := range slice {
+		key := fn(entry)
+		res[key] = append(res[key], entry)
+	}
+	return
+}
+
+func (c *chainCustomType) GroupByString(fn func(CustomType) string) map[string][]CustomType {
+	return GroupCustomTypeByString(c.value, fn)
+}
+
+func KeyCustomTypeByString(slice []CustomType, fn func(CustomType) string) (res map[string]CustomType) {
+	res = make(map[string]CustomType, len(slice))
+	for _, entry := range slice {
+		res[fn(entry)] = entry
+	}
+	return
+}
+
+func (c *chainCustomType) KeyByString(fn func(CustomType) string) map[string]CustomType {
+	return KeyCustomTypeByString(c.value, fn)
+}
+
+func CountCustomTypeByString(slice []CustomType, fn func(CustomType) string) (res map[string]int) {
+	res = make(map[string]int)
+	for _, entry := range slice {
+		res[fn(entry)]++
+	}
+	return
+}
+
+func (c *chainCustomType) CountByString(fn func(CustomType) string) map[string]int {
+	return CountCustomTypeByString(c.value, fn)
+}
+
+func PartitionCustomType(slice []CustomType, fn func(CustomType, int) bool) (yes []CustomType, no []CustomType) {
+	yes = []CustomType{}
+	no = []CustomType{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) Partition(fn func(CustomType, int) bool) ([]CustomType, []CustomType) {
+	return PartitionCustomType(c.value, fn)
+}
+
+func FindCustomType(slice []CustomType, fn func(CustomType, int) bool) (res CustomType, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) Find(fn func(CustomType, int) bool) (CustomType, bool) {
+	return FindCustomType(c.value, fn)
+}
+
+func FindIndexCustomType(slice []CustomType, fn func(CustomType, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainCustomType) FindIndex(fn func(CustomType, int) bool) int {
+	return FindIndexCustomType(c.value, fn)
+}
+
+func FindLastCustomType(slice []CustomType, fn func(CustomType, int) bool) (res CustomType, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) FindLast(fn func(CustomType, int) bool) (CustomType, bool) {
+	return FindLastCustomType(c.value, fn)
+}
+
+func FindLastIndexCustomType(slice []CustomType, fn func(CustomType, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainCustomType) FindLastIndex(fn func(CustomType, int) bool) int {
+	return FindLastIndexCustomType(c.value, fn)
+}
+
+func IndexOfCustomType(slice []CustomType, item CustomType) int {
+	for index, val := range slice {
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainCustomType) IndexOf(item CustomType) int {
+	return IndexOfCustomType(c.value, item)
+}
+
+func LastIndexOfCustomType(slice []CustomType, item CustomType) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainCustomType) LastIndexOf(item CustomType) int {
+	return LastIndexOfCustomType(c.value, item)
+}
+
+func EveryCustomType(slice []CustomType, fn func(CustomType, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCustomType) Every(fn func(CustomType, int) bool) bool {
+	return EveryCustomType(c.value, fn)
+}
+
+func SomeCustomType(slice []CustomType, fn func(CustomType, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainCustomType) Some(fn func(CustomType, int) bool) bool {
+	return SomeCustomType(c.value, fn)
+}
+
+func NoneCustomType(slice []CustomType, fn func(CustomType, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCustomType) None(fn func(CustomType, int) bool) bool {
+	return NoneCustomType(c.value, fn)
+}
+
+func CountCustomType(slice []CustomType, fn func(CustomType, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) Count(fn func(CustomType, int) bool) int {
+	return CountCustomType(c.value, fn)
+}
+
+func TakeCustomType(slice []CustomType, n int) (res []CustomType) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]CustomType, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainCustomType) Take(n int) *chainCustomType {
+	return &chainCustomType{value: TakeCustomType(c.value, n), err: c.err}
+}
+
+func TakeRightCustomType(slice []CustomType, n int) (res []CustomType) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]CustomType, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainCustomType) TakeRight(n int) *chainCustomType {
+	return &chainCustomType{value: TakeRightCustomType(c.value, n), err: c.err}
+}
+
+func TakeWhileCustomType(slice []CustomType, fn func(CustomType, int) bool) (res []CustomType) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]CustomType, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainCustomType) TakeWhile(fn func(CustomType, int) bool) *chainCustomType {
+	return &chainCustomType{value: TakeWhileCustomType(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileCustomType(slice []CustomType, fn func(CustomType, int) bool) (res []CustomType) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]CustomType, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainCustomType) TakeRightWhile(fn func(CustomType, int) bool) *chainCustomType {
+	return &chainCustomType{value: TakeRightWhileCustomType(c.value, fn), err: c.err}
+}
+
+func DropWhileCustomType(slice []CustomType, fn func(CustomType, int) bool) (res []CustomType) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]CustomType, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainCustomType) DropWhile(fn func(CustomType, int) bool) *chainCustomType {
+	return &chainCustomType{value: DropWhileCustomType(c.value, fn), err: c.err}
+}
+
+func DropRightWhileCustomType(slice []CustomType, fn func(CustomType, int) bool) (res []CustomType) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]CustomType, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainCustomType) DropRightWhile(fn func(CustomType, int) bool) *chainCustomType {
+	return &chainCustomType{value: DropRightWhileCustomType(c.value, fn), err: c.err}
+}
+
+func SliceCustomType(slice []CustomType, start int, end int) (res []CustomType) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]CustomType, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainCustomType) Slice(start int, end int) *chainCustomType {
+	return &chainCustomType{value: SliceCustomType(c.value, start, end), err: c.err}
+}
+
+type chainCustomTypeSlices struct {
+	value [][]CustomType
+	err   error
+}
+
+func NewCustomTypeSlices(slices [][]CustomType) *chainCustomTypeSlices {
+	return &chainCustomTypeSlices{value: slices}
+}
+
+func (c *chainCustomTypeSlices) Value() [][]CustomType {
+	return c.value
+}
+
+func (c *chainCustomTypeSlices) Result() ([][]CustomType, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainCustomTypeSlices) Each(fn func([]CustomType, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainCustomTypeSlices) Map(fn func([]CustomType, int) []CustomType) *chainCustomTypeSlices {
+	res := make([][]CustomType, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainCustomTypeSlices{value: res, err: c.err}
+}
+
+func (c *chainCustomTypeSlices) Reduce(fn func(CustomType, CustomType, int) CustomType, initial CustomType) *chainCustomType {
+	res := make([]CustomType, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainCustomType{value: res, err: c.err}
+}
+
+func ChunkCustomType(slice []CustomType, size int) (res [][]CustomType) {
+	res = [][]CustomType{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]CustomType, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainCustomType) Chunk(size int) *chainCustomTypeSlices {
+	return &chainCustomTypeSlices{value: ChunkCustomType(c.value, size), err: c.err}
+}
+
+func WindowCustomType(slice []CustomType, size int, step int) (res [][]CustomType) {
+	res = [][]CustomType{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]CustomType, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainCustomType) Window(size int, step int) *chainCustomTypeSlices {
+	return &chainCustomTypeSlices{value: WindowCustomType(c.value, size, step), err: c.err}
+}
+
+func FlattenCustomType(slices [][]CustomType) (res []CustomType) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]CustomType, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainCustomTypeSlices) Flatten() *chainCustomType {
+	return &chainCustomType{value: FlattenCustomType(c.value), err: c.err}
+}
+
+func FlatMapCustomType(slice []CustomType, fn func(CustomType, int) []CustomType) (res []CustomType) {
+	mapped := make([][]CustomType, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]CustomType, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainCustomType) FlatMap(fn func(CustomType, int) []CustomType) *chainCustomType {
+	return &chainCustomType{value: FlatMapCustomType(c.value, fn), err: c.err}
+}
+
+func MinCustomType(slice []CustomType) (res CustomType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if entry.Less(res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainCustomType) Min() (CustomType, bool) {
+	return MinCustomType(c.value)
+}
+
+func MaxCustomType(slice []CustomType) (res CustomType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if res.Less(entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainCustomType) Max() (CustomType, bool) {
+	return MaxCustomType(c.value)
+}
+
+func MinByCustomType(slice []CustomType, less func(a, b CustomType) bool) (res CustomType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainCustomType) MinBy(less func(a, b CustomType) bool) (CustomType, bool) {
+	return MinByCustomType(c.value, less)
+}
+
+func MaxByCustomType(slice []CustomType, less func(a, b CustomType) bool) (res CustomType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainCustomType) MaxBy(less func(a, b CustomType) bool) (CustomType, bool) {
+	return MaxByCustomType(c.value, less)
+}
+
+func ShuffleCustomType(slice []CustomType, rnd interface{ Intn(int) int }) (res []CustomType) {
+	res = make([]CustomType, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainCustomType) Shuffle(rnd interface{ Intn(int) int }) *chainCustomType {
+	return &chainCustomType{value: ShuffleCustomType(c.value, rnd), err: c.err}
+}
+
+func SampleCustomType(slice []CustomType, rnd interface{ Intn(int) int }) (res CustomType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainCustomType) Sample(rnd interface{ Intn(int) int }) (CustomType, bool) {
+	return SampleCustomType(c.value, rnd)
+}
+
+func SampleNCustomType(slice []CustomType, n int, rnd interface{ Intn(int) int }) (res []CustomType) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]CustomType, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) SampleN(n int, rnd interface{ Intn(int) int }) *chainCustomType {
+	return &chainCustomType{value: SampleNCustomType(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceCustomType(slice []CustomType, weight func(CustomType) float64, rnd interface{ Float64() float64 }) (res CustomType, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainCustomType) WeightedChoice(weight func(CustomType) float64, rnd interface{ Float64() float64 }) (CustomType, bool) {
+	return WeightedChoiceCustomType(c.value, weight, rnd)
+}
+
+// lazyCustomTypeStep is a step of a lazyCustomType. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyCustomTypeStep struct {
+	start func() func(CustomType) (CustomType, bool, bool)
+	apply func([]CustomType) []CustomType
+}
+
+type lazyCustomType struct {
+	isPtr  bool
+	err    error
+	source []CustomType
+	steps  []lazyCustomTypeStep
+}
+
+func (c *chainCustomType) Lazy() *lazyCustomType {
+	return &lazyCustomType{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyCustomType) then(step lazyCustomTypeStep) *lazyCustomType {
+	// copied, so that chains can branch from c
+	steps := make([]lazyCustomTypeStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyCustomType{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyCustomType) Filter(fn func(CustomType, int) bool) *lazyCustomType {
+	return c.then(lazyCustomTypeStep{start: func() func(CustomType) (CustomType, bool, bool) {
+		index := 0
+		return func(entry CustomType) (CustomType, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyCustomType) Map(fn func(CustomType, int) CustomType) *lazyCustomType {
+	return c.then(lazyCustomTypeStep{start: func() func(CustomType) (CustomType, bool, bool) {
+		index := 0
+		return func(entry CustomType) (CustomType, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyCustomType) Drop(n int) *lazyCustomType {
+	return c.then(lazyCustomTypeStep{start: func() func(CustomType) (CustomType, bool, bool) {
+		seen := 0
+		return func(entry CustomType) (CustomType, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyCustomType) Take(n int) *lazyCustomType {
+	return c.then(lazyCustomTypeStep{start: func() func(CustomType) (CustomType, bool, bool) {
+		taken := 0
+		return func(entry CustomType) (CustomType, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyCustomType) TakeWhile(fn func(CustomType, int) bool) *lazyCustomType {
+	return c.then(lazyCustomTypeStep{start: func() func(CustomType) (CustomType, bool, bool) {
+		index := 0
+		return func(entry CustomType) (CustomType, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyCustomType) DropWhile(fn func(CustomType, int) bool) *lazyCustomType {
+	return c.then(lazyCustomTypeStep{start: func() func(CustomType) (CustomType, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry CustomType) (CustomType, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyCustomType) Reverse() *lazyCustomType {
+	return c.then(lazyCustomTypeStep{apply: func(slice []CustomType) []CustomType {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyCustomType) DropRight(n int) *lazyCustomType {
+	return c.then(lazyCustomTypeStep{apply: func(slice []CustomType) []CustomType {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyCustomType) TakeRight(n int) *lazyCustomType {
+	return c.then(lazyCustomTypeStep{apply: func(slice []CustomType) []CustomType {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyCustomType) Value() []CustomType {
+	res := make([]CustomType, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(CustomType) (CustomType, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyCustomType) Eager() *chainCustomType {
+	return &chainCustomType{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelCustomType calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelCustomType(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachCustomType(slice []CustomType, fn func(CustomType, int), workers int) {
+	runParallelCustomType(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelCustomType struct {
+	isPtr   bool
+	err     error
+	value   []CustomType
+	workers int
+}
+
+func (c *chainCustomType) Parallel(workers int) *parallelCustomType {
+	return &parallelCustomType{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelCustomType) ForEach(fn func(CustomType, int)) {
+	ParallelForEachCustomType(c.value, fn, c.workers)
+}
+
+func (c *parallelCustomType) Value() []CustomType {
+	return c.value
+}
+
+func (c *parallelCustomType) Result() ([]CustomType, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelCustomType) Sequential() *chainCustomType {
+	return &chainCustomType{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapCustomType(slice []CustomType, fn func(CustomType, int) CustomType, workers int) (res []CustomType) {
+	res = make([]CustomType, len(slice))
+	runParallelCustomType(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelCustomType) Map(fn func(CustomType, int) CustomType) *parallelCustomType {
+	return &parallelCustomType{value: ParallelMapCustomType(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterCustomType(slice []CustomType, fn func(CustomType, int) bool, workers int) (res []CustomType) {
+	keep := make([]bool, len(slice))
+	runParallelCustomType(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]CustomType, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelCustomType) Filter(fn func(CustomType, int) bool) *parallelCustomType {
+	return &parallelCustomType{value: ParallelFilterCustomType(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrCustomType(slice []CustomType, fn func(CustomType, int) (CustomType, error)) (res []CustomType, err error) {
+	res = make([]CustomType, 0, len(slice))
+	for index, entry := range slice {
+		var val CustomType
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainCustomType) MapErr(fn func(CustomType, int) (CustomType, error)) *chainCustomType {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrCustomType(c.value, fn)
+	return &chainCustomType{value: res, err: err}
+}
+
+func FilterErrCustomType(slice []CustomType, fn func(CustomType, int) (bool, error)) (res []CustomType, err error) {
+	res = make([]CustomType, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCustomType) FilterErr(fn func(CustomType, int) (bool, error)) *chainCustomType {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrCustomType(c.value, fn)
+	return &chainCustomType{value: res, err: err}
+}
+
+func ReduceErrCustomType(slice []CustomType, fn func(CustomType, CustomType, int) (CustomType, error), initial CustomType) (res CustomType, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainCustomType) ReduceErr(fn func(CustomType, CustomType, int) (CustomType, error), initial CustomType) *chainCustomType {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrCustomType(c.value, fn, initial)
+	if err != nil {
+		return &chainCustomType{err: err}
+	}
+	return &chainCustomType{value: []CustomType{res}}
+}
+
+func ForEachErrCustomType(slice []CustomType, fn func(CustomType, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainCustomType) ForEachErr(fn func(CustomType, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrCustomType(c.value, fn)
+}
+
+func MapCtxCustomType(ctx context.Context, slice []CustomType, fn func(CustomType, int) (CustomType, error)) ([]CustomType, error) {
+	return MapErrCustomType(slice, func(entry CustomType, index int) (CustomType, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainCustomType) MapCtx(ctx context.Context, fn func(CustomType, int) (CustomType, error)) *chainCustomType {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxCustomType(ctx, c.value, fn)
+	return &chainCustomType{value: res, err: err}
+}
+
+func FilterCtxCustomType(ctx context.Context, slice []CustomType, fn func(CustomType, int) (bool, error)) ([]CustomType, error) {
+	return FilterErrCustomType(slice, func(entry CustomType, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainCustomType) FilterCtx(ctx context.Context, fn func(CustomType, int) (bool, error)) *chainCustomType {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxCustomType(ctx, c.value, fn)
+	return &chainCustomType{value: res, err: err}
+}
+
+func ReduceCtxCustomType(ctx context.Context, slice []CustomType, fn func(CustomType, CustomType, int) (CustomType, error), initial CustomType) (CustomType, error) {
+	return ReduceErrCustomType(slice, func(acc CustomType, entry CustomType, index int) (CustomType, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainCustomType) ReduceCtx(ctx context.Context, fn func(CustomType, CustomType, int) (CustomType, error), initial CustomType) *chainCustomType {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxCustomType(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainCustomType{err: err}
+	}
+	return &chainCustomType{value: []CustomType{res}}
+}
+
+func ForEachCtxCustomType(ctx context.Context, slice []CustomType, fn func(CustomType, int) error) error {
+	return ForEachErrCustomType(slice, func(entry CustomType, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainCustomType) ForEachCtx(ctx context.Context, fn func(CustomType, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxCustomType(ctx, c.value, fn)
+}
+
+func ParallelMapCtxCustomType(ctx context.Context, slice []CustomType, fn func(CustomType, int) (CustomType, error), workers int) ([]CustomType, error) {
+	res := make([]CustomType, len(slice))
+	err := runParallelCustomType(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelCustomType) MapCtx(ctx context.Context, fn func(CustomType, int) (CustomType, error)) *parallelCustomType {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxCustomType(ctx, c.value, fn, c.workers)
+	return &parallelCustomType{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxCustomType(ctx context.Context, slice []CustomType, fn func(CustomType, int) (bool, error), workers int) ([]CustomType, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelCustomType(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]CustomType, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelCustomType) FilterCtx(ctx context.Context, fn func(CustomType, int) (bool, error)) *parallelCustomType {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxCustomType(ctx, c.value, fn, c.workers)
+	return &parallelCustomType{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxCustomType(ctx context.Context, slice []CustomType, fn func(CustomType, int) error, workers int) error {
+	return runParallelCustomType(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelCustomType) ForEachCtx(ctx context.Context, fn func(CustomType, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxCustomType(ctx, c.value, fn, c.workers)
 }
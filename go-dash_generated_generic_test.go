@@ -0,0 +1,2877 @@
+// This code is generated by https://github.com/jtyers/slice
+// DO NOT EDIT!
+
+//go:build go1.18
+// +build go1.18
+
+package main
+
+import (
+	"context"
+	"github.com/jtyers/slice/generic"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+type chainFloat64 struct {
+	isPtr bool
+	value []float64
+	err   error
+}
+
+func NewFloat64Slice(slice []float64) *chainFloat64 {
+	return &chainFloat64{
+		value: slice,
+	}
+}
+
+func (c *chainFloat64) Value() []float64 {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainFloat64) Result() ([]float64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func ConcatFloat64(slice []float64, slice2 []float64) (res []float64) {
+	return generic.Concat(slice, slice2)
+}
+
+func (c *chainFloat64) Concat(slice2 []float64) *chainFloat64 {
+	return &chainFloat64{value: ConcatFloat64(c.value, slice2), err: c.err}
+}
+
+func ContainsFloat64(slice []float64, item float64) (res bool) {
+	return generic.Contains(slice, item)
+}
+
+func (c *chainFloat64) Contains(item float64) bool {
+	return ContainsFloat64(c.value, item)
+}
+
+func DropFloat64(slice []float64, n int) (res []float64) {
+	return generic.Drop(slice, n)
+}
+
+func (c *chainFloat64) Drop(n int) *chainFloat64 {
+	return &chainFloat64{value: DropFloat64(c.value, n), err: c.err}
+}
+
+func DropRightFloat64(slice []float64, n int) (res []float64) {
+	return generic.DropRight(slice, n)
+}
+
+func (c *chainFloat64) DropRight(n int) *chainFloat64 {
+	return &chainFloat64{value: DropRightFloat64(c.value, n), err: c.err}
+}
+
+func FilterFloat64(slice []float64, fn func(float64, int) bool) (res []float64) {
+	return generic.Filter(slice, fn)
+}
+
+func (c *chainFloat64) Filter(fn func(float64, int) bool) *chainFloat64 {
+	return &chainFloat64{value: FilterFloat64(c.value, fn), err: c.err}
+}
+
+func FirstFloat64(slice []float64) (res float64) {
+	return generic.First(slice)
+}
+
+func (c *chainFloat64) First() *chainFloat64 {
+	return &chainFloat64{value: []float64{FirstFloat64(c.value)}, err: c.err}
+}
+
+func LastFloat64(slice []float64) (res float64) {
+	return generic.Last(slice)
+}
+
+func (c *chainFloat64) Last() *chainFloat64 {
+	return &chainFloat64{value: []float64{LastFloat64(c.value)}, err: c.err}
+}
+
+func MapFloat64(slice []float64, fn func(float64, int) float64) (res []float64) {
+	return generic.Map(slice, fn)
+}
+
+func (c *chainFloat64) Map(fn func(float64, int) float64) *chainFloat64 {
+	return &chainFloat64{value: MapFloat64(c.value, fn), err: c.err}
+}
+
+func ReduceFloat64(slice []float64, fn func(float64, float64, int) float64, initial float64) (res float64) {
+	return generic.Reduce(slice, fn, initial)
+}
+
+func (c *chainFloat64) Reduce(fn func(float64, float64, int) float64, initial float64) *chainFloat64 {
+	return &chainFloat64{value: []float64{ReduceFloat64(c.value, fn, initial)}, err: c.err}
+}
+
+func ReverseFloat64(slice []float64) (res []float64) {
+	return generic.Reverse(slice)
+}
+
+func (c *chainFloat64) Reverse() *chainFloat64 {
+	return &chainFloat64{value: ReverseFloat64(c.value), err: c.err}
+}
+
+func UniqFloat64(slice []float64) (res []float64) {
+	return generic.Uniq(slice)
+}
+
+func (c *chainFloat64) Uniq() *chainFloat64 {
+	if c.isPtr {
+		panic("Uniq() does not currently support pointers")
+	}
+	return &chainFloat64{value: UniqFloat64(c.value), err: c.err}
+}
+
+func SortFloat64(slice []float64) (res []float64) {
+	res = make([]float64, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainFloat64) Sort() *chainFloat64 {
+	return &chainFloat64{value: SortFloat64(c.value), err: c.err}
+}
+
+func SortStableFloat64(slice []float64) (res []float64) {
+	res = make([]float64, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainFloat64) SortStable() *chainFloat64 {
+	return &chainFloat64{value: SortStableFloat64(c.value), err: c.err}
+}
+
+func IsSortedFloat64(slice []float64) bool {
+	for index := 1; index < len(slice); index++ {
+		if slice[index] < slice[index-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64) IsSorted() bool {
+	return IsSortedFloat64(c.value)
+}
+
+func SortByFloat64(slice []float64, less func(a, b float64) bool) (res []float64) {
+	res = make([]float64, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainFloat64) SortBy(less func(a, b float64) bool) *chainFloat64 {
+	return &chainFloat64{value: SortByFloat64(c.value, less), err: c.err}
+}
+
+func SortStableByFloat64(slice []float64, less func(a, b float64) bool) (res []float64) {
+	res = make([]float64, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainFloat64) SortStableBy(less func(a, b float64) bool) *chainFloat64 {
+	return &chainFloat64{value: SortStableByFloat64(c.value, less), err: c.err}
+}
+
+func IsSortedByFloat64(slice []float64, less func(a, b float64) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64) IsSortedBy(less func(a, b float64) bool) bool {
+	return IsSortedByFloat64(c.value, less)
+}
+
+func UnionFloat64(slice []float64, slice2 []float64) (res []float64) {
+	res = []float64{}
+	seen := make(map[float64]bool)
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) Union(slice2 []float64) *chainFloat64 {
+	return &chainFloat64{value: UnionFloat64(c.value, slice2), err: c.err}
+}
+
+func IntersectionFloat64(slice []float64, slice2 []float64) (res []float64) {
+	res = []float64{}
+	in := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	seen := make(map[float64]bool)
+	for _, entry := range slice {
+		if in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) Intersection(slice2 []float64) *chainFloat64 {
+	return &chainFloat64{value: IntersectionFloat64(c.value, slice2), err: c.err}
+}
+
+func DifferenceFloat64(slice []float64, slice2 []float64) (res []float64) {
+	res = []float64{}
+	seen := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) Difference(slice2 []float64) *chainFloat64 {
+	return &chainFloat64{value: DifferenceFloat64(c.value, slice2), err: c.err}
+}
+
+func XorFloat64(slice []float64, slice2 []float64) (res []float64) {
+	res = []float64{}
+	in := make(map[float64]bool, len(slice))
+	for _, entry := range slice {
+		in[entry] = true
+	}
+	in2 := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[entry] = true
+	}
+	seen := make(map[float64]bool)
+	for _, entry := range slice {
+		if !in2[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) Xor(slice2 []float64) *chainFloat64 {
+	return &chainFloat64{value: XorFloat64(c.value, slice2), err: c.err}
+}
+
+func IsSubsetFloat64(slice []float64, slice2 []float64) bool {
+	in := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if !in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64) IsSubset(slice2 []float64) bool {
+	return IsSubsetFloat64(c.value, slice2)
+}
+
+func IsDisjointFloat64(slice []float64, slice2 []float64) bool {
+	in := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64) IsDisjoint(slice2 []float64) bool {
+	return IsDisjointFloat64(c.value, slice2)
+}
+
+func PartitionFloat64(slice []float64, fn func(float64, int) bool) (yes []float64, no []float64) {
+	yes = []float64{}
+	no = []float64{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) Partition(fn func(float64, int) bool) ([]float64, []float64) {
+	return PartitionFloat64(c.value, fn)
+}
+
+func FindFloat64(slice []float64, fn func(float64, int) bool) (res float64, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) Find(fn func(float64, int) bool) (float64, bool) {
+	return FindFloat64(c.value, fn)
+}
+
+func FindIndexFloat64(slice []float64, fn func(float64, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainFloat64) FindIndex(fn func(float64, int) bool) int {
+	return FindIndexFloat64(c.value, fn)
+}
+
+func FindLastFloat64(slice []float64, fn func(float64, int) bool) (res float64, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) FindLast(fn func(float64, int) bool) (float64, bool) {
+	return FindLastFloat64(c.value, fn)
+}
+
+func FindLastIndexFloat64(slice []float64, fn func(float64, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainFloat64) FindLastIndex(fn func(float64, int) bool) int {
+	return FindLastIndexFloat64(c.value, fn)
+}
+
+func IndexOfFloat64(slice []float64, item float64) int {
+	for index, val := range slice {
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainFloat64) IndexOf(item float64) int {
+	return IndexOfFloat64(c.value, item)
+}
+
+func LastIndexOfFloat64(slice []float64, item float64) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainFloat64) LastIndexOf(item float64) int {
+	return LastIndexOfFloat64(c.value, item)
+}
+
+func EveryFloat64(slice []float64, fn func(float64, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64) Every(fn func(float64, int) bool) bool {
+	return EveryFloat64(c.value, fn)
+}
+
+func SomeFloat64(slice []float64, fn func(float64, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainFloat64) Some(fn func(float64, int) bool) bool {
+	return SomeFloat64(c.value, fn)
+}
+
+func NoneFloat64(slice []float64, fn func(float64, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64) None(fn func(float64, int) bool) bool {
+	return NoneFloat64(c.value, fn)
+}
+
+func CountFloat64(slice []float64, fn func(float64, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) Count(fn func(float64, int) bool) int {
+	return CountFloat64(c.value, fn)
+}
+
+func TakeFloat64(slice []float64, n int) (res []float64) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]float64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainFloat64) Take(n int) *chainFloat64 {
+	return &chainFloat64{value: TakeFloat64(c.value, n), err: c.err}
+}
+
+func TakeRightFloat64(slice []float64, n int) (res []float64) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]float64, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainFloat64) TakeRight(n int) *chainFloat64 {
+	return &chainFloat64{value: TakeRightFloat64(c.value, n), err: c.err}
+}
+
+func TakeWhileFloat64(slice []float64, fn func(float64, int) bool) (res []float64) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]float64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainFloat64) TakeWhile(fn func(float64, int) bool) *chainFloat64 {
+	return &chainFloat64{value: TakeWhileFloat64(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileFloat64(slice []float64, fn func(float64, int) bool) (res []float64) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]float64, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainFloat64) TakeRightWhile(fn func(float64, int) bool) *chainFloat64 {
+	return &chainFloat64{value: TakeRightWhileFloat64(c.value, fn), err: c.err}
+}
+
+func DropWhileFloat64(slice []float64, fn func(float64, int) bool) (res []float64) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]float64, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainFloat64) DropWhile(fn func(float64, int) bool) *chainFloat64 {
+	return &chainFloat64{value: DropWhileFloat64(c.value, fn), err: c.err}
+}
+
+func DropRightWhileFloat64(slice []float64, fn func(float64, int) bool) (res []float64) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]float64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainFloat64) DropRightWhile(fn func(float64, int) bool) *chainFloat64 {
+	return &chainFloat64{value: DropRightWhileFloat64(c.value, fn), err: c.err}
+}
+
+func SliceFloat64(slice []float64, start int, end int) (res []float64) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]float64, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainFloat64) Slice(start int, end int) *chainFloat64 {
+	return &chainFloat64{value: SliceFloat64(c.value, start, end), err: c.err}
+}
+
+type chainFloat64Slices struct {
+	value [][]float64
+	err   error
+}
+
+func NewFloat64Slices(slices [][]float64) *chainFloat64Slices {
+	return &chainFloat64Slices{value: slices}
+}
+
+func (c *chainFloat64Slices) Value() [][]float64 {
+	return c.value
+}
+
+func (c *chainFloat64Slices) Result() ([][]float64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainFloat64Slices) Each(fn func([]float64, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainFloat64Slices) Map(fn func([]float64, int) []float64) *chainFloat64Slices {
+	res := make([][]float64, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainFloat64Slices{value: res, err: c.err}
+}
+
+func (c *chainFloat64Slices) Reduce(fn func(float64, float64, int) float64, initial float64) *chainFloat64 {
+	res := make([]float64, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainFloat64{value: res, err: c.err}
+}
+
+func ChunkFloat64(slice []float64, size int) (res [][]float64) {
+	res = [][]float64{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]float64, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainFloat64) Chunk(size int) *chainFloat64Slices {
+	return &chainFloat64Slices{value: ChunkFloat64(c.value, size), err: c.err}
+}
+
+func WindowFloat64(slice []float64, size int, step int) (res [][]float64) {
+	res = [][]float64{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]float64, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainFloat64) Window(size int, step int) *chainFloat64Slices {
+	return &chainFloat64Slices{value: WindowFloat64(c.value, size, step), err: c.err}
+}
+
+func FlattenFloat64(slices [][]float64) (res []float64) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]float64, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainFloat64Slices) Flatten() *chainFloat64 {
+	return &chainFloat64{value: FlattenFloat64(c.value), err: c.err}
+}
+
+func FlatMapFloat64(slice []float64, fn func(float64, int) []float64) (res []float64) {
+	mapped := make([][]float64, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]float64, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainFloat64) FlatMap(fn func(float64, int) []float64) *chainFloat64 {
+	return &chainFloat64{value: FlatMapFloat64(c.value, fn), err: c.err}
+}
+
+func SumFloat64(slice []float64) (res float64) {
+	for _, entry := range slice {
+		res += entry
+	}
+	return
+}
+
+func (c *chainFloat64) Sum() float64 {
+	return SumFloat64(c.value)
+}
+
+func ProductFloat64(slice []float64) (res float64) {
+	res = 1
+	for _, entry := range slice {
+		res *= entry
+	}
+	return
+}
+
+func (c *chainFloat64) Product() float64 {
+	return ProductFloat64(c.value)
+}
+
+func MinFloat64(slice []float64) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if entry < res {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainFloat64) Min() (float64, bool) {
+	return MinFloat64(c.value)
+}
+
+func MaxFloat64(slice []float64) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if res < entry {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainFloat64) Max() (float64, bool) {
+	return MaxFloat64(c.value)
+}
+
+func MinByFloat64(slice []float64, less func(a, b float64) bool) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainFloat64) MinBy(less func(a, b float64) bool) (float64, bool) {
+	return MinByFloat64(c.value, less)
+}
+
+func MaxByFloat64(slice []float64, less func(a, b float64) bool) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainFloat64) MaxBy(less func(a, b float64) bool) (float64, bool) {
+	return MaxByFloat64(c.value, less)
+}
+
+func MeanFloat64(slice []float64) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	for _, entry := range slice {
+		res += float64(entry)
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainFloat64) Mean() (float64, bool) {
+	return MeanFloat64(c.value)
+}
+
+func MedianFloat64(slice []float64) (float64, bool) {
+	return PercentileFloat64(slice, 50)
+}
+
+func (c *chainFloat64) Median() (float64, bool) {
+	return MedianFloat64(c.value)
+}
+
+func PercentileFloat64(slice []float64, p float64) (res float64, ok bool) {
+	if len(slice) == 0 || !(p >= 0 && p <= 100) {
+		return
+	}
+
+	sorted := make([]float64, len(slice))
+	for index, entry := range slice {
+		sorted[index] = float64(entry)
+	}
+	sort.Float64s(sorted)
+
+	// interpolate between the closest ranks
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower == len(sorted)-1 {
+		return sorted[lower], true
+	}
+	return sorted[lower] + (sorted[lower+1]-sorted[lower])*(rank-float64(lower)), true
+}
+
+func (c *chainFloat64) Percentile(p float64) (float64, bool) {
+	return PercentileFloat64(c.value, p)
+}
+
+func VarianceFloat64(slice []float64) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+
+	mean := 0.0
+	for _, entry := range slice {
+		mean += float64(entry)
+	}
+	mean /= float64(len(slice))
+
+	for _, entry := range slice {
+		d := float64(entry) - mean
+		res += d * d
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainFloat64) Variance() (float64, bool) {
+	return VarianceFloat64(c.value)
+}
+
+func StdDevFloat64(slice []float64) (float64, bool) {
+	variance, ok := VarianceFloat64(slice)
+	return math.Sqrt(variance), ok
+}
+
+func (c *chainFloat64) StdDev() (float64, bool) {
+	return StdDevFloat64(c.value)
+}
+
+func ShuffleFloat64(slice []float64, rnd interface{ Intn(int) int }) (res []float64) {
+	res = make([]float64, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainFloat64) Shuffle(rnd interface{ Intn(int) int }) *chainFloat64 {
+	return &chainFloat64{value: ShuffleFloat64(c.value, rnd), err: c.err}
+}
+
+func SampleFloat64(slice []float64, rnd interface{ Intn(int) int }) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainFloat64) Sample(rnd interface{ Intn(int) int }) (float64, bool) {
+	return SampleFloat64(c.value, rnd)
+}
+
+func SampleNFloat64(slice []float64, n int, rnd interface{ Intn(int) int }) (res []float64) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]float64, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) SampleN(n int, rnd interface{ Intn(int) int }) *chainFloat64 {
+	return &chainFloat64{value: SampleNFloat64(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceFloat64(slice []float64, weight func(float64) float64, rnd interface{ Float64() float64 }) (res float64, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainFloat64) WeightedChoice(weight func(float64) float64, rnd interface{ Float64() float64 }) (float64, bool) {
+	return WeightedChoiceFloat64(c.value, weight, rnd)
+}
+
+// lazyFloat64Step is a step of a lazyFloat64. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyFloat64Step struct {
+	start func() func(float64) (float64, bool, bool)
+	apply func([]float64) []float64
+}
+
+type lazyFloat64 struct {
+	isPtr  bool
+	err    error
+	source []float64
+	steps  []lazyFloat64Step
+}
+
+func (c *chainFloat64) Lazy() *lazyFloat64 {
+	return &lazyFloat64{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyFloat64) then(step lazyFloat64Step) *lazyFloat64 {
+	// copied, so that chains can branch from c
+	steps := make([]lazyFloat64Step, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyFloat64{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyFloat64) Filter(fn func(float64, int) bool) *lazyFloat64 {
+	return c.then(lazyFloat64Step{start: func() func(float64) (float64, bool, bool) {
+		index := 0
+		return func(entry float64) (float64, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyFloat64) Map(fn func(float64, int) float64) *lazyFloat64 {
+	return c.then(lazyFloat64Step{start: func() func(float64) (float64, bool, bool) {
+		index := 0
+		return func(entry float64) (float64, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyFloat64) Drop(n int) *lazyFloat64 {
+	return c.then(lazyFloat64Step{start: func() func(float64) (float64, bool, bool) {
+		seen := 0
+		return func(entry float64) (float64, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyFloat64) Take(n int) *lazyFloat64 {
+	return c.then(lazyFloat64Step{start: func() func(float64) (float64, bool, bool) {
+		taken := 0
+		return func(entry float64) (float64, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyFloat64) TakeWhile(fn func(float64, int) bool) *lazyFloat64 {
+	return c.then(lazyFloat64Step{start: func() func(float64) (float64, bool, bool) {
+		index := 0
+		return func(entry float64) (float64, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyFloat64) DropWhile(fn func(float64, int) bool) *lazyFloat64 {
+	return c.then(lazyFloat64Step{start: func() func(float64) (float64, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry float64) (float64, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyFloat64) Reverse() *lazyFloat64 {
+	return c.then(lazyFloat64Step{apply: func(slice []float64) []float64 {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyFloat64) DropRight(n int) *lazyFloat64 {
+	return c.then(lazyFloat64Step{apply: func(slice []float64) []float64 {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyFloat64) TakeRight(n int) *lazyFloat64 {
+	return c.then(lazyFloat64Step{apply: func(slice []float64) []float64 {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyFloat64) Value() []float64 {
+	res := make([]float64, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(float64) (float64, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyFloat64) Eager() *chainFloat64 {
+	return &chainFloat64{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelFloat64 calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelFloat64(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachFloat64(slice []float64, fn func(float64, int), workers int) {
+	runParallelFloat64(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelFloat64 struct {
+	isPtr   bool
+	err     error
+	value   []float64
+	workers int
+}
+
+func (c *chainFloat64) Parallel(workers int) *parallelFloat64 {
+	return &parallelFloat64{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelFloat64) ForEach(fn func(float64, int)) {
+	ParallelForEachFloat64(c.value, fn, c.workers)
+}
+
+func (c *parallelFloat64) Value() []float64 {
+	return c.value
+}
+
+func (c *parallelFloat64) Result() ([]float64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelFloat64) Sequential() *chainFloat64 {
+	return &chainFloat64{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapFloat64(slice []float64, fn func(float64, int) float64, workers int) (res []float64) {
+	res = make([]float64, len(slice))
+	runParallelFloat64(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelFloat64) Map(fn func(float64, int) float64) *parallelFloat64 {
+	return &parallelFloat64{value: ParallelMapFloat64(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterFloat64(slice []float64, fn func(float64, int) bool, workers int) (res []float64) {
+	keep := make([]bool, len(slice))
+	runParallelFloat64(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]float64, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelFloat64) Filter(fn func(float64, int) bool) *parallelFloat64 {
+	return &parallelFloat64{value: ParallelFilterFloat64(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrFloat64(slice []float64, fn func(float64, int) (float64, error)) (res []float64, err error) {
+	res = make([]float64, 0, len(slice))
+	for index, entry := range slice {
+		var val float64
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainFloat64) MapErr(fn func(float64, int) (float64, error)) *chainFloat64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrFloat64(c.value, fn)
+	return &chainFloat64{value: res, err: err}
+}
+
+func FilterErrFloat64(slice []float64, fn func(float64, int) (bool, error)) (res []float64, err error) {
+	res = make([]float64, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64) FilterErr(fn func(float64, int) (bool, error)) *chainFloat64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrFloat64(c.value, fn)
+	return &chainFloat64{value: res, err: err}
+}
+
+func ReduceErrFloat64(slice []float64, fn func(float64, float64, int) (float64, error), initial float64) (res float64, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainFloat64) ReduceErr(fn func(float64, float64, int) (float64, error), initial float64) *chainFloat64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrFloat64(c.value, fn, initial)
+	if err != nil {
+		return &chainFloat64{err: err}
+	}
+	return &chainFloat64{value: []float64{res}}
+}
+
+func ForEachErrFloat64(slice []float64, fn func(float64, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainFloat64) ForEachErr(fn func(float64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrFloat64(c.value, fn)
+}
+
+func MapCtxFloat64(ctx context.Context, slice []float64, fn func(float64, int) (float64, error)) ([]float64, error) {
+	return MapErrFloat64(slice, func(entry float64, index int) (float64, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainFloat64) MapCtx(ctx context.Context, fn func(float64, int) (float64, error)) *chainFloat64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxFloat64(ctx, c.value, fn)
+	return &chainFloat64{value: res, err: err}
+}
+
+func FilterCtxFloat64(ctx context.Context, slice []float64, fn func(float64, int) (bool, error)) ([]float64, error) {
+	return FilterErrFloat64(slice, func(entry float64, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainFloat64) FilterCtx(ctx context.Context, fn func(float64, int) (bool, error)) *chainFloat64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxFloat64(ctx, c.value, fn)
+	return &chainFloat64{value: res, err: err}
+}
+
+func ReduceCtxFloat64(ctx context.Context, slice []float64, fn func(float64, float64, int) (float64, error), initial float64) (float64, error) {
+	return ReduceErrFloat64(slice, func(acc float64, entry float64, index int) (float64, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainFloat64) ReduceCtx(ctx context.Context, fn func(float64, float64, int) (float64, error), initial float64) *chainFloat64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxFloat64(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainFloat64{err: err}
+	}
+	return &chainFloat64{value: []float64{res}}
+}
+
+func ForEachCtxFloat64(ctx context.Context, slice []float64, fn func(float64, int) error) error {
+	return ForEachErrFloat64(slice, func(entry float64, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainFloat64) ForEachCtx(ctx context.Context, fn func(float64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxFloat64(ctx, c.value, fn)
+}
+
+func ParallelMapCtxFloat64(ctx context.Context, slice []float64, fn func(float64, int) (float64, error), workers int) ([]float64, error) {
+	res := make([]float64, len(slice))
+	err := runParallelFloat64(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelFloat64) MapCtx(ctx context.Context, fn func(float64, int) (float64, error)) *parallelFloat64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxFloat64(ctx, c.value, fn, c.workers)
+	return &parallelFloat64{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxFloat64(ctx context.Context, slice []float64, fn func(float64, int) (bool, error), workers int) ([]float64, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelFloat64(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]float64, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelFloat64) FilterCtx(ctx context.Context, fn func(float64, int) (bool, error)) *parallelFloat64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxFloat64(ctx, c.value, fn, c.workers)
+	return &parallelFloat64{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxFloat64(ctx context.Context, slice []float64, fn func(float64, int) error, workers int) error {
+	return runParallelFloat64(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelFloat64) ForEachCtx(ctx context.Context, fn func(float64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxFloat64(ctx, c.value, fn, c.workers)
+}
+
+type chainFloat64Ptr struct {
+	isPtr bool
+	value []*float64
+	err   error
+}
+
+func NewFloat64PtrSlice(slice []*float64) *chainFloat64Ptr {
+	return &chainFloat64Ptr{
+		value: slice,
+		isPtr: true,
+	}
+}
+
+func (c *chainFloat64Ptr) Value() []*float64 {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainFloat64Ptr) Result() ([]*float64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func ConcatFloat64Ptr(slice []*float64, slice2 []*float64) (res []*float64) {
+	return generic.Concat(slice, slice2)
+}
+
+func (c *chainFloat64Ptr) Concat(slice2 []*float64) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: ConcatFloat64Ptr(c.value, slice2), err: c.err}
+}
+
+func ContainsFloat64Ptr(slice []*float64, item *float64) (res bool) {
+	return generic.ContainsFunc(slice, item, func(a, b *float64) bool { return a == b || *a == *b })
+}
+
+func (c *chainFloat64Ptr) Contains(item *float64) bool {
+	return ContainsFloat64Ptr(c.value, item)
+}
+
+func DropFloat64Ptr(slice []*float64, n int) (res []*float64) {
+	return generic.Drop(slice, n)
+}
+
+func (c *chainFloat64Ptr) Drop(n int) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: DropFloat64Ptr(c.value, n), err: c.err}
+}
+
+func DropRightFloat64Ptr(slice []*float64, n int) (res []*float64) {
+	return generic.DropRight(slice, n)
+}
+
+func (c *chainFloat64Ptr) DropRight(n int) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: DropRightFloat64Ptr(c.value, n), err: c.err}
+}
+
+func FilterFloat64Ptr(slice []*float64, fn func(*float64, int) bool) (res []*float64) {
+	return generic.Filter(slice, fn)
+}
+
+func (c *chainFloat64Ptr) Filter(fn func(*float64, int) bool) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: FilterFloat64Ptr(c.value, fn), err: c.err}
+}
+
+func FirstFloat64Ptr(slice []*float64) (res *float64) {
+	return generic.First(slice)
+}
+
+func (c *chainFloat64Ptr) First() *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: []*float64{FirstFloat64Ptr(c.value)}, err: c.err}
+}
+
+func LastFloat64Ptr(slice []*float64) (res *float64) {
+	return generic.Last(slice)
+}
+
+func (c *chainFloat64Ptr) Last() *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: []*float64{LastFloat64Ptr(c.value)}, err: c.err}
+}
+
+func MapFloat64Ptr(slice []*float64, fn func(*float64, int) *float64) (res []*float64) {
+	return generic.Map(slice, fn)
+}
+
+func (c *chainFloat64Ptr) Map(fn func(*float64, int) *float64) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: MapFloat64Ptr(c.value, fn), err: c.err}
+}
+
+func ReduceFloat64Ptr(slice []*float64, fn func(*float64, *float64, int) *float64, initial *float64) (res *float64) {
+	return generic.Reduce(slice, fn, initial)
+}
+
+func (c *chainFloat64Ptr) Reduce(fn func(*float64, *float64, int) *float64, initial *float64) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: []*float64{ReduceFloat64Ptr(c.value, fn, initial)}, err: c.err}
+}
+
+func ReverseFloat64Ptr(slice []*float64) (res []*float64) {
+	return generic.Reverse(slice)
+}
+
+func (c *chainFloat64Ptr) Reverse() *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: ReverseFloat64Ptr(c.value), err: c.err}
+}
+
+func UniqFloat64Ptr(slice []*float64) (res []*float64) {
+	return generic.Uniq(slice)
+}
+
+func (c *chainFloat64Ptr) Uniq() *chainFloat64Ptr {
+	if c.isPtr {
+		panic("Uniq() does not currently support pointers")
+	}
+	return &chainFloat64Ptr{value: UniqFloat64Ptr(c.value), err: c.err}
+}
+
+func SortFloat64Ptr(slice []*float64) (res []*float64) {
+	res = make([]*float64, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return *res[i] < *res[j]
+	})
+	return
+}
+
+func (c *chainFloat64Ptr) Sort() *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: SortFloat64Ptr(c.value), err: c.err}
+}
+
+func SortStableFloat64Ptr(slice []*float64) (res []*float64) {
+	res = make([]*float64, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return *res[i] < *res[j]
+	})
+	return
+}
+
+func (c *chainFloat64Ptr) SortStable() *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: SortStableFloat64Ptr(c.value), err: c.err}
+}
+
+func IsSortedFloat64Ptr(slice []*float64) bool {
+	for index := 1; index < len(slice); index++ {
+		if *slice[index] < *slice[index-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64Ptr) IsSorted() bool {
+	return IsSortedFloat64Ptr(c.value)
+}
+
+func SortByFloat64Ptr(slice []*float64, less func(a, b *float64) bool) (res []*float64) {
+	res = make([]*float64, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainFloat64Ptr) SortBy(less func(a, b *float64) bool) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: SortByFloat64Ptr(c.value, less), err: c.err}
+}
+
+func SortStableByFloat64Ptr(slice []*float64, less func(a, b *float64) bool) (res []*float64) {
+	res = make([]*float64, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainFloat64Ptr) SortStableBy(less func(a, b *float64) bool) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: SortStableByFloat64Ptr(c.value, less), err: c.err}
+}
+
+func IsSortedByFloat64Ptr(slice []*float64, less func(a, b *float64) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64Ptr) IsSortedBy(less func(a, b *float64) bool) bool {
+	return IsSortedByFloat64Ptr(c.value, less)
+}
+
+func UnionFloat64Ptr(slice []*float64, slice2 []*float64) (res []*float64) {
+	res = []*float64{}
+	seen := make(map[float64]bool)
+	for _, entry := range slice {
+		if !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Union(slice2 []*float64) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: UnionFloat64Ptr(c.value, slice2), err: c.err}
+}
+
+func IntersectionFloat64Ptr(slice []*float64, slice2 []*float64) (res []*float64) {
+	res = []*float64{}
+	in := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[*entry] = true
+	}
+	seen := make(map[float64]bool)
+	for _, entry := range slice {
+		if in[*entry] && !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Intersection(slice2 []*float64) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: IntersectionFloat64Ptr(c.value, slice2), err: c.err}
+}
+
+func DifferenceFloat64Ptr(slice []*float64, slice2 []*float64) (res []*float64) {
+	res = []*float64{}
+	seen := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[*entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Difference(slice2 []*float64) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: DifferenceFloat64Ptr(c.value, slice2), err: c.err}
+}
+
+func XorFloat64Ptr(slice []*float64, slice2 []*float64) (res []*float64) {
+	res = []*float64{}
+	in := make(map[float64]bool, len(slice))
+	for _, entry := range slice {
+		in[*entry] = true
+	}
+	in2 := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[*entry] = true
+	}
+	seen := make(map[float64]bool)
+	for _, entry := range slice {
+		if !in2[*entry] && !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[*entry] && !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Xor(slice2 []*float64) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: XorFloat64Ptr(c.value, slice2), err: c.err}
+}
+
+func IsSubsetFloat64Ptr(slice []*float64, slice2 []*float64) bool {
+	in := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[*entry] = true
+	}
+	for _, entry := range slice {
+		if !in[*entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64Ptr) IsSubset(slice2 []*float64) bool {
+	return IsSubsetFloat64Ptr(c.value, slice2)
+}
+
+func IsDisjointFloat64Ptr(slice []*float64, slice2 []*float64) bool {
+	in := make(map[float64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[*entry] = true
+	}
+	for _, entry := range slice {
+		if in[*entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64Ptr) IsDisjoint(slice2 []*float64) bool {
+	return IsDisjointFloat64Ptr(c.value, slice2)
+}
+
+func PartitionFloat64Ptr(slice []*float64, fn func(*float64, int) bool) (yes []*float64, no []*float64) {
+	yes = []*float64{}
+	no = []*float64{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Partition(fn func(*float64, int) bool) ([]*float64, []*float64) {
+	return PartitionFloat64Ptr(c.value, fn)
+}
+
+func FindFloat64Ptr(slice []*float64, fn func(*float64, int) bool) (res *float64, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Find(fn func(*float64, int) bool) (*float64, bool) {
+	return FindFloat64Ptr(c.value, fn)
+}
+
+func FindIndexFloat64Ptr(slice []*float64, fn func(*float64, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainFloat64Ptr) FindIndex(fn func(*float64, int) bool) int {
+	return FindIndexFloat64Ptr(c.value, fn)
+}
+
+func FindLastFloat64Ptr(slice []*float64, fn func(*float64, int) bool) (res *float64, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) FindLast(fn func(*float64, int) bool) (*float64, bool) {
+	return FindLastFloat64Ptr(c.value, fn)
+}
+
+func FindLastIndexFloat64Ptr(slice []*float64, fn func(*float64, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainFloat64Ptr) FindLastIndex(fn func(*float64, int) bool) int {
+	return FindLastIndexFloat64Ptr(c.value, fn)
+}
+
+func IndexOfFloat64Ptr(slice []*float64, item *float64) int {
+	for index, val := range slice {
+		if val == item || *val == *item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainFloat64Ptr) IndexOf(item *float64) int {
+	return IndexOfFloat64Ptr(c.value, item)
+}
+
+func LastIndexOfFloat64Ptr(slice []*float64, item *float64) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item || *val == *item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainFloat64Ptr) LastIndexOf(item *float64) int {
+	return LastIndexOfFloat64Ptr(c.value, item)
+}
+
+func EveryFloat64Ptr(slice []*float64, fn func(*float64, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64Ptr) Every(fn func(*float64, int) bool) bool {
+	return EveryFloat64Ptr(c.value, fn)
+}
+
+func SomeFloat64Ptr(slice []*float64, fn func(*float64, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainFloat64Ptr) Some(fn func(*float64, int) bool) bool {
+	return SomeFloat64Ptr(c.value, fn)
+}
+
+func NoneFloat64Ptr(slice []*float64, fn func(*float64, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainFloat64Ptr) None(fn func(*float64, int) bool) bool {
+	return NoneFloat64Ptr(c.value, fn)
+}
+
+func CountFloat64Ptr(slice []*float64, fn func(*float64, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Count(fn func(*float64, int) bool) int {
+	return CountFloat64Ptr(c.value, fn)
+}
+
+func TakeFloat64Ptr(slice []*float64, n int) (res []*float64) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*float64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainFloat64Ptr) Take(n int) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: TakeFloat64Ptr(c.value, n), err: c.err}
+}
+
+func TakeRightFloat64Ptr(slice []*float64, n int) (res []*float64) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*float64, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainFloat64Ptr) TakeRight(n int) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: TakeRightFloat64Ptr(c.value, n), err: c.err}
+}
+
+func TakeWhileFloat64Ptr(slice []*float64, fn func(*float64, int) bool) (res []*float64) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]*float64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainFloat64Ptr) TakeWhile(fn func(*float64, int) bool) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: TakeWhileFloat64Ptr(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileFloat64Ptr(slice []*float64, fn func(*float64, int) bool) (res []*float64) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]*float64, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainFloat64Ptr) TakeRightWhile(fn func(*float64, int) bool) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: TakeRightWhileFloat64Ptr(c.value, fn), err: c.err}
+}
+
+func DropWhileFloat64Ptr(slice []*float64, fn func(*float64, int) bool) (res []*float64) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]*float64, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainFloat64Ptr) DropWhile(fn func(*float64, int) bool) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: DropWhileFloat64Ptr(c.value, fn), err: c.err}
+}
+
+func DropRightWhileFloat64Ptr(slice []*float64, fn func(*float64, int) bool) (res []*float64) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]*float64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainFloat64Ptr) DropRightWhile(fn func(*float64, int) bool) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: DropRightWhileFloat64Ptr(c.value, fn), err: c.err}
+}
+
+func SliceFloat64Ptr(slice []*float64, start int, end int) (res []*float64) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]*float64, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainFloat64Ptr) Slice(start int, end int) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: SliceFloat64Ptr(c.value, start, end), err: c.err}
+}
+
+type chainFloat64PtrSlices struct {
+	value [][]*float64
+	err   error
+}
+
+func NewFloat64PtrSlices(slices [][]*float64) *chainFloat64PtrSlices {
+	return &chainFloat64PtrSlices{value: slices}
+}
+
+func (c *chainFloat64PtrSlices) Value() [][]*float64 {
+	return c.value
+}
+
+func (c *chainFloat64PtrSlices) Result() ([][]*float64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainFloat64PtrSlices) Each(fn func([]*float64, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainFloat64PtrSlices) Map(fn func([]*float64, int) []*float64) *chainFloat64PtrSlices {
+	res := make([][]*float64, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainFloat64PtrSlices{value: res, err: c.err}
+}
+
+func (c *chainFloat64PtrSlices) Reduce(fn func(*float64, *float64, int) *float64, initial *float64) *chainFloat64Ptr {
+	res := make([]*float64, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainFloat64Ptr{value: res, isPtr: true, err: c.err}
+}
+
+func ChunkFloat64Ptr(slice []*float64, size int) (res [][]*float64) {
+	res = [][]*float64{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]*float64, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Chunk(size int) *chainFloat64PtrSlices {
+	return &chainFloat64PtrSlices{value: ChunkFloat64Ptr(c.value, size), err: c.err}
+}
+
+func WindowFloat64Ptr(slice []*float64, size int, step int) (res [][]*float64) {
+	res = [][]*float64{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]*float64, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Window(size int, step int) *chainFloat64PtrSlices {
+	return &chainFloat64PtrSlices{value: WindowFloat64Ptr(c.value, size, step), err: c.err}
+}
+
+func FlattenFloat64Ptr(slices [][]*float64) (res []*float64) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]*float64, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainFloat64PtrSlices) Flatten() *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: FlattenFloat64Ptr(c.value), isPtr: true, err: c.err}
+}
+
+func FlatMapFloat64Ptr(slice []*float64, fn func(*float64, int) []*float64) (res []*float64) {
+	mapped := make([][]*float64, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]*float64, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) FlatMap(fn func(*float64, int) []*float64) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: FlatMapFloat64Ptr(c.value, fn), isPtr: true, err: c.err}
+}
+
+func MinFloat64Ptr(slice []*float64) (res *float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if *entry < *res {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainFloat64Ptr) Min() (*float64, bool) {
+	return MinFloat64Ptr(c.value)
+}
+
+func MaxFloat64Ptr(slice []*float64) (res *float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if *res < *entry {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainFloat64Ptr) Max() (*float64, bool) {
+	return MaxFloat64Ptr(c.value)
+}
+
+func MinByFloat64Ptr(slice []*float64, less func(a, b *float64) bool) (res *float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainFloat64Ptr) MinBy(less func(a, b *float64) bool) (*float64, bool) {
+	return MinByFloat64Ptr(c.value, less)
+}
+
+func MaxByFloat64Ptr(slice []*float64, less func(a, b *float64) bool) (res *float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainFloat64Ptr) MaxBy(less func(a, b *float64) bool) (*float64, bool) {
+	return MaxByFloat64Ptr(c.value, less)
+}
+
+func ShuffleFloat64Ptr(slice []*float64, rnd interface{ Intn(int) int }) (res []*float64) {
+	res = make([]*float64, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) Shuffle(rnd interface{ Intn(int) int }) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: ShuffleFloat64Ptr(c.value, rnd), err: c.err}
+}
+
+func SampleFloat64Ptr(slice []*float64, rnd interface{ Intn(int) int }) (res *float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainFloat64Ptr) Sample(rnd interface{ Intn(int) int }) (*float64, bool) {
+	return SampleFloat64Ptr(c.value, rnd)
+}
+
+func SampleNFloat64Ptr(slice []*float64, n int, rnd interface{ Intn(int) int }) (res []*float64) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]*float64, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) SampleN(n int, rnd interface{ Intn(int) int }) *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: SampleNFloat64Ptr(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceFloat64Ptr(slice []*float64, weight func(*float64) float64, rnd interface{ Float64() float64 }) (res *float64, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainFloat64Ptr) WeightedChoice(weight func(*float64) float64, rnd interface{ Float64() float64 }) (*float64, bool) {
+	return WeightedChoiceFloat64Ptr(c.value, weight, rnd)
+}
+
+// lazyFloat64PtrStep is a step of a lazyFloat64Ptr. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyFloat64PtrStep struct {
+	start func() func(*float64) (*float64, bool, bool)
+	apply func([]*float64) []*float64
+}
+
+type lazyFloat64Ptr struct {
+	isPtr  bool
+	err    error
+	source []*float64
+	steps  []lazyFloat64PtrStep
+}
+
+func (c *chainFloat64Ptr) Lazy() *lazyFloat64Ptr {
+	return &lazyFloat64Ptr{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyFloat64Ptr) then(step lazyFloat64PtrStep) *lazyFloat64Ptr {
+	// copied, so that chains can branch from c
+	steps := make([]lazyFloat64PtrStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyFloat64Ptr{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyFloat64Ptr) Filter(fn func(*float64, int) bool) *lazyFloat64Ptr {
+	return c.then(lazyFloat64PtrStep{start: func() func(*float64) (*float64, bool, bool) {
+		index := 0
+		return func(entry *float64) (*float64, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyFloat64Ptr) Map(fn func(*float64, int) *float64) *lazyFloat64Ptr {
+	return c.then(lazyFloat64PtrStep{start: func() func(*float64) (*float64, bool, bool) {
+		index := 0
+		return func(entry *float64) (*float64, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyFloat64Ptr) Drop(n int) *lazyFloat64Ptr {
+	return c.then(lazyFloat64PtrStep{start: func() func(*float64) (*float64, bool, bool) {
+		seen := 0
+		return func(entry *float64) (*float64, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyFloat64Ptr) Take(n int) *lazyFloat64Ptr {
+	return c.then(lazyFloat64PtrStep{start: func() func(*float64) (*float64, bool, bool) {
+		taken := 0
+		return func(entry *float64) (*float64, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyFloat64Ptr) TakeWhile(fn func(*float64, int) bool) *lazyFloat64Ptr {
+	return c.then(lazyFloat64PtrStep{start: func() func(*float64) (*float64, bool, bool) {
+		index := 0
+		return func(entry *float64) (*float64, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyFloat64Ptr) DropWhile(fn func(*float64, int) bool) *lazyFloat64Ptr {
+	return c.then(lazyFloat64PtrStep{start: func() func(*float64) (*float64, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry *float64) (*float64, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyFloat64Ptr) Reverse() *lazyFloat64Ptr {
+	return c.then(lazyFloat64PtrStep{apply: func(slice []*float64) []*float64 {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyFloat64Ptr) DropRight(n int) *lazyFloat64Ptr {
+	return c.then(lazyFloat64PtrStep{apply: func(slice []*float64) []*float64 {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyFloat64Ptr) TakeRight(n int) *lazyFloat64Ptr {
+	return c.then(lazyFloat64PtrStep{apply: func(slice []*float64) []*float64 {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyFloat64Ptr) Value() []*float64 {
+	res := make([]*float64, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(*float64) (*float64, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyFloat64Ptr) Eager() *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelFloat64Ptr calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelFloat64Ptr(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachFloat64Ptr(slice []*float64, fn func(*float64, int), workers int) {
+	runParallelFloat64Ptr(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelFloat64Ptr struct {
+	isPtr   bool
+	err     error
+	value   []*float64
+	workers int
+}
+
+func (c *chainFloat64Ptr) Parallel(workers int) *parallelFloat64Ptr {
+	return &parallelFloat64Ptr{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelFloat64Ptr) ForEach(fn func(*float64, int)) {
+	ParallelForEachFloat64Ptr(c.value, fn, c.workers)
+}
+
+func (c *parallelFloat64Ptr) Value() []*float64 {
+	return c.value
+}
+
+func (c *parallelFloat64Ptr) Result() ([]*float64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelFloat64Ptr) Sequential() *chainFloat64Ptr {
+	return &chainFloat64Ptr{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapFloat64Ptr(slice []*float64, fn func(*float64, int) *float64, workers int) (res []*float64) {
+	res = make([]*float64, len(slice))
+	runParallelFloat64Ptr(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelFloat64Ptr) Map(fn func(*float64, int) *float64) *parallelFloat64Ptr {
+	return &parallelFloat64Ptr{value: ParallelMapFloat64Ptr(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterFloat64Ptr(slice []*float64, fn func(*float64, int) bool, workers int) (res []*float64) {
+	keep := make([]bool, len(slice))
+	runParallelFloat64Ptr(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]*float64, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelFloat64Ptr) Filter(fn func(*float64, int) bool) *parallelFloat64Ptr {
+	return &parallelFloat64Ptr{value: ParallelFilterFloat64Ptr(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrFloat64Ptr(slice []*float64, fn func(*float64, int) (*float64, error)) (res []*float64, err error) {
+	res = make([]*float64, 0, len(slice))
+	for index, entry := range slice {
+		var val *float64
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) MapErr(fn func(*float64, int) (*float64, error)) *chainFloat64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrFloat64Ptr(c.value, fn)
+	return &chainFloat64Ptr{value: res, err: err}
+}
+
+func FilterErrFloat64Ptr(slice []*float64, fn func(*float64, int) (bool, error)) (res []*float64, err error) {
+	res = make([]*float64, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainFloat64Ptr) FilterErr(fn func(*float64, int) (bool, error)) *chainFloat64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrFloat64Ptr(c.value, fn)
+	return &chainFloat64Ptr{value: res, err: err}
+}
+
+func ReduceErrFloat64Ptr(slice []*float64, fn func(*float64, *float64, int) (*float64, error), initial *float64) (res *float64, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainFloat64Ptr) ReduceErr(fn func(*float64, *float64, int) (*float64, error), initial *float64) *chainFloat64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrFloat64Ptr(c.value, fn, initial)
+	if err != nil {
+		return &chainFloat64Ptr{err: err}
+	}
+	return &chainFloat64Ptr{value: []*float64{res}}
+}
+
+func ForEachErrFloat64Ptr(slice []*float64, fn func(*float64, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainFloat64Ptr) ForEachErr(fn func(*float64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrFloat64Ptr(c.value, fn)
+}
+
+func MapCtxFloat64Ptr(ctx context.Context, slice []*float64, fn func(*float64, int) (*float64, error)) ([]*float64, error) {
+	return MapErrFloat64Ptr(slice, func(entry *float64, index int) (*float64, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainFloat64Ptr) MapCtx(ctx context.Context, fn func(*float64, int) (*float64, error)) *chainFloat64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxFloat64Ptr(ctx, c.value, fn)
+	return &chainFloat64Ptr{value: res, err: err}
+}
+
+func FilterCtxFloat64Ptr(ctx context.Context, slice []*float64, fn func(*float64, int) (bool, error)) ([]*float64, error) {
+	return FilterErrFloat64Ptr(slice, func(entry *float64, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainFloat64Ptr) FilterCtx(ctx context.Context, fn func(*float64, int) (bool, error)) *chainFloat64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxFloat64Ptr(ctx, c.value, fn)
+	return &chainFloat64Ptr{value: res, err: err}
+}
+
+func ReduceCtxFloat64Ptr(ctx context.Context, slice []*float64, fn func(*float64, *float64, int) (*float64, error), initial *float64) (*float64, error) {
+	return ReduceErrFloat64Ptr(slice, func(acc *float64, entry *float64, index int) (*float64, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainFloat64Ptr) ReduceCtx(ctx context.Context, fn func(*float64, *float64, int) (*float64, error), initial *float64) *chainFloat64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxFloat64Ptr(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainFloat64Ptr{err: err}
+	}
+	return &chainFloat64Ptr{value: []*float64{res}}
+}
+
+func ForEachCtxFloat64Ptr(ctx context.Context, slice []*float64, fn func(*float64, int) error) error {
+	return ForEachErrFloat64Ptr(slice, func(entry *float64, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainFloat64Ptr) ForEachCtx(ctx context.Context, fn func(*float64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxFloat64Ptr(ctx, c.value, fn)
+}
+
+func ParallelMapCtxFloat64Ptr(ctx context.Context, slice []*float64, fn func(*float64, int) (*float64, error), workers int) ([]*float64, error) {
+	res := make([]*float64, len(slice))
+	err := runParallelFloat64Ptr(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelFloat64Ptr) MapCtx(ctx context.Context, fn func(*float64, int) (*float64, error)) *parallelFloat64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxFloat64Ptr(ctx, c.value, fn, c.workers)
+	return &parallelFloat64Ptr{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxFloat64Ptr(ctx context.Context, slice []*float64, fn func(*float64, int) (bool, error), workers int) ([]*float64, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelFloat64Ptr(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*float64, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelFloat64Ptr) FilterCtx(ctx context.Context, fn func(*float64, int) (bool, error)) *parallelFloat64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxFloat64Ptr(ctx, c.value, fn, c.workers)
+	return &parallelFloat64Ptr{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxFloat64Ptr(ctx context.Context, slice []*float64, fn func(*float64, int) error, workers int) error {
+	return runParallelFloat64Ptr(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelFloat64Ptr) ForEachCtx(ctx context.Context, fn func(*float64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxFloat64Ptr(ctx, c.value, fn, c.workers)
+}
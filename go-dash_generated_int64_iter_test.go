@@ -0,0 +1,169 @@
+// This code is generated by https://github.com/jtyers/slice
+// DO NOT EDIT!
+
+//go:build go1.23
+// +build go1.23
+
+package main
+
+import (
+	"iter"
+)
+
+func AllInt64(slice []int64) iter.Seq2[int, int64] {
+	return func(yield func(int, int64) bool) {
+		for index, entry := range slice {
+			if !yield(index, entry) {
+				return
+			}
+		}
+	}
+}
+
+func (c *chainInt64) All() iter.Seq2[int, int64] {
+	return AllInt64(c.value)
+}
+
+func ValuesInt64(slice []int64) iter.Seq[int64] {
+	return func(yield func(int64) bool) {
+		for _, entry := range slice {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+func (c *chainInt64) Values() iter.Seq[int64] {
+	return ValuesInt64(c.value)
+}
+
+func FromSeqInt64(seq iter.Seq[int64]) *chainInt64 {
+	res := []int64{}
+	for entry := range seq {
+		res = append(res, entry)
+	}
+	return NewInt64Slice(res)
+}
+
+func FilterSeqInt64(seq iter.Seq[int64], fn func(int64, int) bool) iter.Seq[int64] {
+	return func(yield func(int64) bool) {
+		index := 0
+		for entry := range seq {
+			keep := fn(entry, index)
+			index++
+			if keep && !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+func MapSeqInt64(seq iter.Seq[int64], fn func(int64, int) int64) iter.Seq[int64] {
+	return func(yield func(int64) bool) {
+		index := 0
+		for entry := range seq {
+			if !yield(fn(entry, index)) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+func TakeSeqInt64(seq iter.Seq[int64], n int) iter.Seq[int64] {
+	return func(yield func(int64) bool) {
+		if n < 1 {
+			return
+		}
+		taken := 0
+		for entry := range seq {
+			if !yield(entry) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
+
+func AllInt64Ptr(slice []*int64) iter.Seq2[int, *int64] {
+	return func(yield func(int, *int64) bool) {
+		for index, entry := range slice {
+			if !yield(index, entry) {
+				return
+			}
+		}
+	}
+}
+
+func (c *chainInt64Ptr) All() iter.Seq2[int, *int64] {
+	return AllInt64Ptr(c.value)
+}
+
+func ValuesInt64Ptr(slice []*int64) iter.Seq[*int64] {
+	return func(yield func(*int64) bool) {
+		for _, entry := range slice {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+func (c *chainInt64Ptr) Values() iter.Seq[*int64] {
+	return ValuesInt64Ptr(c.value)
+}
+
+func FromSeqInt64Ptr(seq iter.Seq[*int64]) *chainInt64Ptr {
+	res := []*int64{}
+	for entry := range seq {
+		res = append(res, entry)
+	}
+	return NewInt64PtrSlice(res)
+}
+
+func FilterSeqInt64Ptr(seq iter.Seq[*int64], fn func(*int64, int) bool) iter.Seq[*int64] {
+	return func(yield func(*int64) bool) {
+		index := 0
+		for entry := range seq {
+			keep := fn(entry, index)
+			index++
+			if keep && !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+func MapSeqInt64Ptr(seq iter.Seq[*int64], fn func(*int64, int) *int64) iter.Seq[*int64] {
+	return func(yield func(*int64) bool) {
+		index := 0
+		for entry := range seq {
+			if !yield(fn(entry, index)) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+func TakeSeqInt64Ptr(seq iter.Seq[*int64], n int) iter.Seq[*int64] {
+	return func(yield func(*int64) bool) {
+		if n < 1 {
+			return
+		}
+		taken := 0
+		for entry := range seq {
+			if !yield(entry) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
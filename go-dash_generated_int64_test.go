@@ -0,0 +1,3030 @@
+// This code is generated by https://github.com/jtyers/slice
+// DO NOT EDIT!
+
+package main
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+type chainInt64 struct {
+	isPtr bool
+	value []int64
+	err   error
+}
+
+func NewInt64Slice(slice []int64) *chainInt64 {
+	return &chainInt64{
+		value: slice,
+	}
+}
+
+func (c *chainInt64) Value() []int64 {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainInt64) Result() ([]int64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func ConcatInt64(slice []int64, slice2 []int64) (res []int64) {
+	res = make([]int64, 0, len(slice)+len(slice2))
+	for _, entry := range slice {
+		res = append(res, entry)
+	}
+	for _, entry := range slice2 {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainInt64) Concat(slice2 []int64) *chainInt64 {
+	return &chainInt64{value: ConcatInt64(c.value, slice2), err: c.err}
+}
+
+func ContainsInt64(slice []int64, item int64) (res bool) {
+	for _, val := range slice {
+		if val == item {
+			return true
+		}
+
+	}
+	return false
+}
+
+func (c *chainInt64) Contains(item int64) bool {
+	return ContainsInt64(c.value, item)
+}
+
+func DropInt64(slice []int64, n int) (res []int64) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]int64, 0, l)
+	for _, entry := range slice[len(slice)-l:] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainInt64) Drop(n int) *chainInt64 {
+	return &chainInt64{value: DropInt64(c.value, n), err: c.err}
+}
+
+func DropRightInt64(slice []int64, n int) (res []int64) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]int64, 0, l)
+	for _, entry := range slice[:l] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainInt64) DropRight(n int) *chainInt64 {
+	return &chainInt64{value: DropRightInt64(c.value, n), err: c.err}
+}
+
+func FilterInt64(slice []int64, fn func(int64, int) bool) (res []int64) {
+	res = make([]int64, 0, len(slice))
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64) Filter(fn func(int64, int) bool) *chainInt64 {
+	return &chainInt64{value: FilterInt64(c.value, fn), err: c.err}
+}
+
+func FirstInt64(slice []int64) (res int64) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	return
+}
+
+func (c *chainInt64) First() *chainInt64 {
+	return &chainInt64{value: []int64{FirstInt64(c.value)}, err: c.err}
+}
+
+func LastInt64(slice []int64) (res int64) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[len(slice)-1]
+	return
+}
+
+func (c *chainInt64) Last() *chainInt64 {
+	return &chainInt64{value: []int64{LastInt64(c.value)}, err: c.err}
+}
+
+func MapInt64(slice []int64, fn func(int64, int) int64) (res []int64) {
+	res = make([]int64, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainInt64) Map(fn func(int64, int) int64) *chainInt64 {
+	return &chainInt64{value: MapInt64(c.value, fn), err: c.err}
+}
+
+func ReduceInt64(slice []int64, fn func(int64, int64, int) int64, initial int64) (res int64) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainInt64) Reduce(fn func(int64, int64, int) int64, initial int64) *chainInt64 {
+	return &chainInt64{value: []int64{ReduceInt64(c.value, fn, initial)}, err: c.err}
+}
+
+func ReverseInt64(slice []int64) (res []int64) {
+	res = make([]int64, len(slice))
+	for index, entry := range slice {
+		res[len(slice)-1-index] = entry
+	}
+	return
+}
+
+func (c *chainInt64) Reverse() *chainInt64 {
+	return &chainInt64{value: ReverseInt64(c.value), err: c.err}
+}
+
+func UniqInt64(slice []int64) (res []int64) {
+	seen := make(map[int64]bool)
+	res = []int64{}
+	for _, entry := range slice {
+		if _, found := seen[entry]; !found {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+
+}
+
+func (c *chainInt64) Uniq() *chainInt64 {
+	if c.isPtr {
+		panic("Uniq() does not currently support pointers")
+	}
+	return &chainInt64{value: UniqInt64(c.value), err: c.err}
+}
+
+func SortInt64(slice []int64) (res []int64) {
+	res = make([]int64, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainInt64) Sort() *chainInt64 {
+	return &chainInt64{value: SortInt64(c.value), err: c.err}
+}
+
+func SortStableInt64(slice []int64) (res []int64) {
+	res = make([]int64, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainInt64) SortStable() *chainInt64 {
+	return &chainInt64{value: SortStableInt64(c.value), err: c.err}
+}
+
+func IsSortedInt64(slice []int64) bool {
+	for index := 1; index < len(slice); index++ {
+		if slice[index] < slice[index-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64) IsSorted() bool {
+	return IsSortedInt64(c.value)
+}
+
+func SortByInt64(slice []int64, less func(a, b int64) bool) (res []int64) {
+	res = make([]int64, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainInt64) SortBy(less func(a, b int64) bool) *chainInt64 {
+	return &chainInt64{value: SortByInt64(c.value, less), err: c.err}
+}
+
+func SortStableByInt64(slice []int64, less func(a, b int64) bool) (res []int64) {
+	res = make([]int64, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainInt64) SortStableBy(less func(a, b int64) bool) *chainInt64 {
+	return &chainInt64{value: SortStableByInt64(c.value, less), err: c.err}
+}
+
+func IsSortedByInt64(slice []int64, less func(a, b int64) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64) IsSortedBy(less func(a, b int64) bool) bool {
+	return IsSortedByInt64(c.value, less)
+}
+
+func UnionInt64(slice []int64, slice2 []int64) (res []int64) {
+	res = []int64{}
+	seen := make(map[int64]bool)
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64) Union(slice2 []int64) *chainInt64 {
+	return &chainInt64{value: UnionInt64(c.value, slice2), err: c.err}
+}
+
+func IntersectionInt64(slice []int64, slice2 []int64) (res []int64) {
+	res = []int64{}
+	in := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	seen := make(map[int64]bool)
+	for _, entry := range slice {
+		if in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64) Intersection(slice2 []int64) *chainInt64 {
+	return &chainInt64{value: IntersectionInt64(c.value, slice2), err: c.err}
+}
+
+func DifferenceInt64(slice []int64, slice2 []int64) (res []int64) {
+	res = []int64{}
+	seen := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64) Difference(slice2 []int64) *chainInt64 {
+	return &chainInt64{value: DifferenceInt64(c.value, slice2), err: c.err}
+}
+
+func XorInt64(slice []int64, slice2 []int64) (res []int64) {
+	res = []int64{}
+	in := make(map[int64]bool, len(slice))
+	for _, entry := range slice {
+		in[entry] = true
+	}
+	in2 := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[entry] = true
+	}
+	seen := make(map[int64]bool)
+	for _, entry := range slice {
+		if !in2[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64) Xor(slice2 []int64) *chainInt64 {
+	return &chainInt64{value: XorInt64(c.value, slice2), err: c.err}
+}
+
+func IsSubsetInt64(slice []int64, slice2 []int64) bool {
+	in := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if !in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64) IsSubset(slice2 []int64) bool {
+	return IsSubsetInt64(c.value, slice2)
+}
+
+func IsDisjointInt64(slice []int64, slice2 []int64) bool {
+	in := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64) IsDisjoint(slice2 []int64) bool {
+	return IsDisjointInt64(c.value, slice2)
+}
+
+func PartitionInt64(slice []int64, fn func(int64, int) bool) (yes []int64, no []int64) {
+	yes = []int64{}
+	no = []int64{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64) Partition(fn func(int64, int) bool) ([]int64, []int64) {
+	return PartitionInt64(c.value, fn)
+}
+
+func FindInt64(slice []int64, fn func(int64, int) bool) (res int64, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainInt64) Find(fn func(int64, int) bool) (int64, bool) {
+	return FindInt64(c.value, fn)
+}
+
+func FindIndexInt64(slice []int64, fn func(int64, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt64) FindIndex(fn func(int64, int) bool) int {
+	return FindIndexInt64(c.value, fn)
+}
+
+func FindLastInt64(slice []int64, fn func(int64, int) bool) (res int64, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainInt64) FindLast(fn func(int64, int) bool) (int64, bool) {
+	return FindLastInt64(c.value, fn)
+}
+
+func FindLastIndexInt64(slice []int64, fn func(int64, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt64) FindLastIndex(fn func(int64, int) bool) int {
+	return FindLastIndexInt64(c.value, fn)
+}
+
+func IndexOfInt64(slice []int64, item int64) int {
+	for index, val := range slice {
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt64) IndexOf(item int64) int {
+	return IndexOfInt64(c.value, item)
+}
+
+func LastIndexOfInt64(slice []int64, item int64) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt64) LastIndexOf(item int64) int {
+	return LastIndexOfInt64(c.value, item)
+}
+
+func EveryInt64(slice []int64, fn func(int64, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64) Every(fn func(int64, int) bool) bool {
+	return EveryInt64(c.value, fn)
+}
+
+func SomeInt64(slice []int64, fn func(int64, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainInt64) Some(fn func(int64, int) bool) bool {
+	return SomeInt64(c.value, fn)
+}
+
+func NoneInt64(slice []int64, fn func(int64, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64) None(fn func(int64, int) bool) bool {
+	return NoneInt64(c.value, fn)
+}
+
+func CountInt64(slice []int64, fn func(int64, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainInt64) Count(fn func(int64, int) bool) int {
+	return CountInt64(c.value, fn)
+}
+
+func TakeInt64(slice []int64, n int) (res []int64) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]int64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainInt64) Take(n int) *chainInt64 {
+	return &chainInt64{value: TakeInt64(c.value, n), err: c.err}
+}
+
+func TakeRightInt64(slice []int64, n int) (res []int64) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]int64, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainInt64) TakeRight(n int) *chainInt64 {
+	return &chainInt64{value: TakeRightInt64(c.value, n), err: c.err}
+}
+
+func TakeWhileInt64(slice []int64, fn func(int64, int) bool) (res []int64) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]int64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainInt64) TakeWhile(fn func(int64, int) bool) *chainInt64 {
+	return &chainInt64{value: TakeWhileInt64(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileInt64(slice []int64, fn func(int64, int) bool) (res []int64) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]int64, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainInt64) TakeRightWhile(fn func(int64, int) bool) *chainInt64 {
+	return &chainInt64{value: TakeRightWhileInt64(c.value, fn), err: c.err}
+}
+
+func DropWhileInt64(slice []int64, fn func(int64, int) bool) (res []int64) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]int64, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainInt64) DropWhile(fn func(int64, int) bool) *chainInt64 {
+	return &chainInt64{value: DropWhileInt64(c.value, fn), err: c.err}
+}
+
+func DropRightWhileInt64(slice []int64, fn func(int64, int) bool) (res []int64) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]int64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainInt64) DropRightWhile(fn func(int64, int) bool) *chainInt64 {
+	return &chainInt64{value: DropRightWhileInt64(c.value, fn), err: c.err}
+}
+
+func SliceInt64(slice []int64, start int, end int) (res []int64) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]int64, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainInt64) Slice(start int, end int) *chainInt64 {
+	return &chainInt64{value: SliceInt64(c.value, start, end), err: c.err}
+}
+
+type chainInt64Slices struct {
+	value [][]int64
+	err   error
+}
+
+func NewInt64Slices(slices [][]int64) *chainInt64Slices {
+	return &chainInt64Slices{value: slices}
+}
+
+func (c *chainInt64Slices) Value() [][]int64 {
+	return c.value
+}
+
+func (c *chainInt64Slices) Result() ([][]int64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainInt64Slices) Each(fn func([]int64, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainInt64Slices) Map(fn func([]int64, int) []int64) *chainInt64Slices {
+	res := make([][]int64, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainInt64Slices{value: res, err: c.err}
+}
+
+func (c *chainInt64Slices) Reduce(fn func(int64, int64, int) int64, initial int64) *chainInt64 {
+	res := make([]int64, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainInt64{value: res, err: c.err}
+}
+
+func ChunkInt64(slice []int64, size int) (res [][]int64) {
+	res = [][]int64{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]int64, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainInt64) Chunk(size int) *chainInt64Slices {
+	return &chainInt64Slices{value: ChunkInt64(c.value, size), err: c.err}
+}
+
+func WindowInt64(slice []int64, size int, step int) (res [][]int64) {
+	res = [][]int64{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]int64, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainInt64) Window(size int, step int) *chainInt64Slices {
+	return &chainInt64Slices{value: WindowInt64(c.value, size, step), err: c.err}
+}
+
+func FlattenInt64(slices [][]int64) (res []int64) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]int64, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainInt64Slices) Flatten() *chainInt64 {
+	return &chainInt64{value: FlattenInt64(c.value), err: c.err}
+}
+
+func FlatMapInt64(slice []int64, fn func(int64, int) []int64) (res []int64) {
+	mapped := make([][]int64, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]int64, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainInt64) FlatMap(fn func(int64, int) []int64) *chainInt64 {
+	return &chainInt64{value: FlatMapInt64(c.value, fn), err: c.err}
+}
+
+func SumInt64(slice []int64) (res int64) {
+	for _, entry := range slice {
+		res += entry
+	}
+	return
+}
+
+func (c *chainInt64) Sum() int64 {
+	return SumInt64(c.value)
+}
+
+func SumCheckedInt64(slice []int64) (res int64, ok bool) {
+	for _, entry := range slice {
+		sum := res + entry
+		if (entry > 0 && sum < res) || (entry < 0 && sum > res) {
+			return 0, false
+		}
+		res = sum
+	}
+	return res, true
+}
+
+func (c *chainInt64) SumChecked() (int64, bool) {
+	return SumCheckedInt64(c.value)
+}
+
+func ProductInt64(slice []int64) (res int64) {
+	res = 1
+	for _, entry := range slice {
+		res *= entry
+	}
+	return
+}
+
+func (c *chainInt64) Product() int64 {
+	return ProductInt64(c.value)
+}
+
+func MinInt64(slice []int64) (res int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if entry < res {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt64) Min() (int64, bool) {
+	return MinInt64(c.value)
+}
+
+func MaxInt64(slice []int64) (res int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if res < entry {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt64) Max() (int64, bool) {
+	return MaxInt64(c.value)
+}
+
+func MinByInt64(slice []int64, less func(a, b int64) bool) (res int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt64) MinBy(less func(a, b int64) bool) (int64, bool) {
+	return MinByInt64(c.value, less)
+}
+
+func MaxByInt64(slice []int64, less func(a, b int64) bool) (res int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt64) MaxBy(less func(a, b int64) bool) (int64, bool) {
+	return MaxByInt64(c.value, less)
+}
+
+func MeanInt64(slice []int64) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	for _, entry := range slice {
+		res += float64(entry)
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainInt64) Mean() (float64, bool) {
+	return MeanInt64(c.value)
+}
+
+func MedianInt64(slice []int64) (float64, bool) {
+	return PercentileInt64(slice, 50)
+}
+
+func (c *chainInt64) Median() (float64, bool) {
+	return MedianInt64(c.value)
+}
+
+func PercentileInt64(slice []int64, p float64) (res float64, ok bool) {
+	if len(slice) == 0 || !(p >= 0 && p <= 100) {
+		return
+	}
+
+	sorted := make([]float64, len(slice))
+	for index, entry := range slice {
+		sorted[index] = float64(entry)
+	}
+	sort.Float64s(sorted)
+
+	// interpolate between the closest ranks
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower == len(sorted)-1 {
+		return sorted[lower], true
+	}
+	return sorted[lower] + (sorted[lower+1]-sorted[lower])*(rank-float64(lower)), true
+}
+
+func (c *chainInt64) Percentile(p float64) (float64, bool) {
+	return PercentileInt64(c.value, p)
+}
+
+func VarianceInt64(slice []int64) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+
+	mean := 0.0
+	for _, entry := range slice {
+		mean += float64(entry)
+	}
+	mean /= float64(len(slice))
+
+	for _, entry := range slice {
+		d := float64(entry) - mean
+		res += d * d
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainInt64) Variance() (float64, bool) {
+	return VarianceInt64(c.value)
+}
+
+func StdDevInt64(slice []int64) (float64, bool) {
+	variance, ok := VarianceInt64(slice)
+	return math.Sqrt(variance), ok
+}
+
+func (c *chainInt64) StdDev() (float64, bool) {
+	return StdDevInt64(c.value)
+}
+
+func ShuffleInt64(slice []int64, rnd interface{ Intn(int) int }) (res []int64) {
+	res = make([]int64, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainInt64) Shuffle(rnd interface{ Intn(int) int }) *chainInt64 {
+	return &chainInt64{value: ShuffleInt64(c.value, rnd), err: c.err}
+}
+
+func SampleInt64(slice []int64, rnd interface{ Intn(int) int }) (res int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainInt64) Sample(rnd interface{ Intn(int) int }) (int64, bool) {
+	return SampleInt64(c.value, rnd)
+}
+
+func SampleNInt64(slice []int64, n int, rnd interface{ Intn(int) int }) (res []int64) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]int64, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainInt64) SampleN(n int, rnd interface{ Intn(int) int }) *chainInt64 {
+	return &chainInt64{value: SampleNInt64(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceInt64(slice []int64, weight func(int64) float64, rnd interface{ Float64() float64 }) (res int64, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainInt64) WeightedChoice(weight func(int64) float64, rnd interface{ Float64() float64 }) (int64, bool) {
+	return WeightedChoiceInt64(c.value, weight, rnd)
+}
+
+// lazyInt64Step is a step of a lazyInt64. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyInt64Step struct {
+	start func() func(int64) (int64, bool, bool)
+	apply func([]int64) []int64
+}
+
+type lazyInt64 struct {
+	isPtr  bool
+	err    error
+	source []int64
+	steps  []lazyInt64Step
+}
+
+func (c *chainInt64) Lazy() *lazyInt64 {
+	return &lazyInt64{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyInt64) then(step lazyInt64Step) *lazyInt64 {
+	// copied, so that chains can branch from c
+	steps := make([]lazyInt64Step, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyInt64{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyInt64) Filter(fn func(int64, int) bool) *lazyInt64 {
+	return c.then(lazyInt64Step{start: func() func(int64) (int64, bool, bool) {
+		index := 0
+		return func(entry int64) (int64, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyInt64) Map(fn func(int64, int) int64) *lazyInt64 {
+	return c.then(lazyInt64Step{start: func() func(int64) (int64, bool, bool) {
+		index := 0
+		return func(entry int64) (int64, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyInt64) Drop(n int) *lazyInt64 {
+	return c.then(lazyInt64Step{start: func() func(int64) (int64, bool, bool) {
+		seen := 0
+		return func(entry int64) (int64, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyInt64) Take(n int) *lazyInt64 {
+	return c.then(lazyInt64Step{start: func() func(int64) (int64, bool, bool) {
+		taken := 0
+		return func(entry int64) (int64, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyInt64) TakeWhile(fn func(int64, int) bool) *lazyInt64 {
+	return c.then(lazyInt64Step{start: func() func(int64) (int64, bool, bool) {
+		index := 0
+		return func(entry int64) (int64, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyInt64) DropWhile(fn func(int64, int) bool) *lazyInt64 {
+	return c.then(lazyInt64Step{start: func() func(int64) (int64, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry int64) (int64, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyInt64) Reverse() *lazyInt64 {
+	return c.then(lazyInt64Step{apply: func(slice []int64) []int64 {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyInt64) DropRight(n int) *lazyInt64 {
+	return c.then(lazyInt64Step{apply: func(slice []int64) []int64 {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyInt64) TakeRight(n int) *lazyInt64 {
+	return c.then(lazyInt64Step{apply: func(slice []int64) []int64 {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyInt64) Value() []int64 {
+	res := make([]int64, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(int64) (int64, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyInt64) Eager() *chainInt64 {
+	return &chainInt64{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelInt64 calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelInt64(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachInt64(slice []int64, fn func(int64, int), workers int) {
+	runParallelInt64(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelInt64 struct {
+	isPtr   bool
+	err     error
+	value   []int64
+	workers int
+}
+
+func (c *chainInt64) Parallel(workers int) *parallelInt64 {
+	return &parallelInt64{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelInt64) ForEach(fn func(int64, int)) {
+	ParallelForEachInt64(c.value, fn, c.workers)
+}
+
+func (c *parallelInt64) Value() []int64 {
+	return c.value
+}
+
+func (c *parallelInt64) Result() ([]int64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelInt64) Sequential() *chainInt64 {
+	return &chainInt64{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapInt64(slice []int64, fn func(int64, int) int64, workers int) (res []int64) {
+	res = make([]int64, len(slice))
+	runParallelInt64(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelInt64) Map(fn func(int64, int) int64) *parallelInt64 {
+	return &parallelInt64{value: ParallelMapInt64(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterInt64(slice []int64, fn func(int64, int) bool, workers int) (res []int64) {
+	keep := make([]bool, len(slice))
+	runParallelInt64(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]int64, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelInt64) Filter(fn func(int64, int) bool) *parallelInt64 {
+	return &parallelInt64{value: ParallelFilterInt64(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrInt64(slice []int64, fn func(int64, int) (int64, error)) (res []int64, err error) {
+	res = make([]int64, 0, len(slice))
+	for index, entry := range slice {
+		var val int64
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainInt64) MapErr(fn func(int64, int) (int64, error)) *chainInt64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrInt64(c.value, fn)
+	return &chainInt64{value: res, err: err}
+}
+
+func FilterErrInt64(slice []int64, fn func(int64, int) (bool, error)) (res []int64, err error) {
+	res = make([]int64, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64) FilterErr(fn func(int64, int) (bool, error)) *chainInt64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrInt64(c.value, fn)
+	return &chainInt64{value: res, err: err}
+}
+
+func ReduceErrInt64(slice []int64, fn func(int64, int64, int) (int64, error), initial int64) (res int64, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainInt64) ReduceErr(fn func(int64, int64, int) (int64, error), initial int64) *chainInt64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrInt64(c.value, fn, initial)
+	if err != nil {
+		return &chainInt64{err: err}
+	}
+	return &chainInt64{value: []int64{res}}
+}
+
+func ForEachErrInt64(slice []int64, fn func(int64, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainInt64) ForEachErr(fn func(int64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrInt64(c.value, fn)
+}
+
+func MapCtxInt64(ctx context.Context, slice []int64, fn func(int64, int) (int64, error)) ([]int64, error) {
+	return MapErrInt64(slice, func(entry int64, index int) (int64, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainInt64) MapCtx(ctx context.Context, fn func(int64, int) (int64, error)) *chainInt64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxInt64(ctx, c.value, fn)
+	return &chainInt64{value: res, err: err}
+}
+
+func FilterCtxInt64(ctx context.Context, slice []int64, fn func(int64, int) (bool, error)) ([]int64, error) {
+	return FilterErrInt64(slice, func(entry int64, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainInt64) FilterCtx(ctx context.Context, fn func(int64, int) (bool, error)) *chainInt64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxInt64(ctx, c.value, fn)
+	return &chainInt64{value: res, err: err}
+}
+
+func ReduceCtxInt64(ctx context.Context, slice []int64, fn func(int64, int64, int) (int64, error), initial int64) (int64, error) {
+	return ReduceErrInt64(slice, func(acc int64, entry int64, index int) (int64, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainInt64) ReduceCtx(ctx context.Context, fn func(int64, int64, int) (int64, error), initial int64) *chainInt64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxInt64(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainInt64{err: err}
+	}
+	return &chainInt64{value: []int64{res}}
+}
+
+func ForEachCtxInt64(ctx context.Context, slice []int64, fn func(int64, int) error) error {
+	return ForEachErrInt64(slice, func(entry int64, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainInt64) ForEachCtx(ctx context.Context, fn func(int64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxInt64(ctx, c.value, fn)
+}
+
+func ParallelMapCtxInt64(ctx context.Context, slice []int64, fn func(int64, int) (int64, error), workers int) ([]int64, error) {
+	res := make([]int64, len(slice))
+	err := runParallelInt64(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelInt64) MapCtx(ctx context.Context, fn func(int64, int) (int64, error)) *parallelInt64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxInt64(ctx, c.value, fn, c.workers)
+	return &parallelInt64{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxInt64(ctx context.Context, slice []int64, fn func(int64, int) (bool, error), workers int) ([]int64, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelInt64(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]int64, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelInt64) FilterCtx(ctx context.Context, fn func(int64, int) (bool, error)) *parallelInt64 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxInt64(ctx, c.value, fn, c.workers)
+	return &parallelInt64{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxInt64(ctx context.Context, slice []int64, fn func(int64, int) error, workers int) error {
+	return runParallelInt64(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelInt64) ForEachCtx(ctx context.Context, fn func(int64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxInt64(ctx, c.value, fn, c.workers)
+}
+
+type chainInt64Ptr struct {
+	isPtr bool
+	value []*int64
+	err   error
+}
+
+func NewInt64PtrSlice(slice []*int64) *chainInt64Ptr {
+	return &chainInt64Ptr{
+		value: slice,
+		isPtr: true,
+	}
+}
+
+func (c *chainInt64Ptr) Value() []*int64 {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainInt64Ptr) Result() ([]*int64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func ConcatInt64Ptr(slice []*int64, slice2 []*int64) (res []*int64) {
+	res = make([]*int64, 0, len(slice)+len(slice2))
+	for _, entry := range slice {
+		res = append(res, entry)
+	}
+	for _, entry := range slice2 {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Concat(slice2 []*int64) *chainInt64Ptr {
+	return &chainInt64Ptr{value: ConcatInt64Ptr(c.value, slice2), err: c.err}
+}
+
+func ContainsInt64Ptr(slice []*int64, item *int64) (res bool) {
+	for _, val := range slice {
+		if val == item {
+			return true
+		}
+		if *val == *item {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainInt64Ptr) Contains(item *int64) bool {
+	return ContainsInt64Ptr(c.value, item)
+}
+
+func DropInt64Ptr(slice []*int64, n int) (res []*int64) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*int64, 0, l)
+	for _, entry := range slice[len(slice)-l:] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Drop(n int) *chainInt64Ptr {
+	return &chainInt64Ptr{value: DropInt64Ptr(c.value, n), err: c.err}
+}
+
+func DropRightInt64Ptr(slice []*int64, n int) (res []*int64) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*int64, 0, l)
+	for _, entry := range slice[:l] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainInt64Ptr) DropRight(n int) *chainInt64Ptr {
+	return &chainInt64Ptr{value: DropRightInt64Ptr(c.value, n), err: c.err}
+}
+
+func FilterInt64Ptr(slice []*int64, fn func(*int64, int) bool) (res []*int64) {
+	res = make([]*int64, 0, len(slice))
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Filter(fn func(*int64, int) bool) *chainInt64Ptr {
+	return &chainInt64Ptr{value: FilterInt64Ptr(c.value, fn), err: c.err}
+}
+
+func FirstInt64Ptr(slice []*int64) (res *int64) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	return
+}
+
+func (c *chainInt64Ptr) First() *chainInt64Ptr {
+	return &chainInt64Ptr{value: []*int64{FirstInt64Ptr(c.value)}, err: c.err}
+}
+
+func LastInt64Ptr(slice []*int64) (res *int64) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[len(slice)-1]
+	return
+}
+
+func (c *chainInt64Ptr) Last() *chainInt64Ptr {
+	return &chainInt64Ptr{value: []*int64{LastInt64Ptr(c.value)}, err: c.err}
+}
+
+func MapInt64Ptr(slice []*int64, fn func(*int64, int) *int64) (res []*int64) {
+	res = make([]*int64, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Map(fn func(*int64, int) *int64) *chainInt64Ptr {
+	return &chainInt64Ptr{value: MapInt64Ptr(c.value, fn), err: c.err}
+}
+
+func ReduceInt64Ptr(slice []*int64, fn func(*int64, *int64, int) *int64, initial *int64) (res *int64) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Reduce(fn func(*int64, *int64, int) *int64, initial *int64) *chainInt64Ptr {
+	return &chainInt64Ptr{value: []*int64{ReduceInt64Ptr(c.value, fn, initial)}, err: c.err}
+}
+
+func ReverseInt64Ptr(slice []*int64) (res []*int64) {
+	res = make([]*int64, len(slice))
+	for index, entry := range slice {
+		res[len(slice)-1-index] = entry
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Reverse() *chainInt64Ptr {
+	return &chainInt64Ptr{value: ReverseInt64Ptr(c.value), err: c.err}
+}
+
+func UniqInt64Ptr(slice []*int64) (res []*int64) {
+	seen := make(map[*int64]bool)
+	res = []*int64{}
+	for _, entry := range slice {
+		if _, found := seen[entry]; !found {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+
+}
+
+func (c *chainInt64Ptr) Uniq() *chainInt64Ptr {
+	if c.isPtr {
+		panic("Uniq() does not currently support pointers")
+	}
+	return &chainInt64Ptr{value: UniqInt64Ptr(c.value), err: c.err}
+}
+
+func SortInt64Ptr(slice []*int64) (res []*int64) {
+	res = make([]*int64, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return *res[i] < *res[j]
+	})
+	return
+}
+
+func (c *chainInt64Ptr) Sort() *chainInt64Ptr {
+	return &chainInt64Ptr{value: SortInt64Ptr(c.value), err: c.err}
+}
+
+func SortStableInt64Ptr(slice []*int64) (res []*int64) {
+	res = make([]*int64, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return *res[i] < *res[j]
+	})
+	return
+}
+
+func (c *chainInt64Ptr) SortStable() *chainInt64Ptr {
+	return &chainInt64Ptr{value: SortStableInt64Ptr(c.value), err: c.err}
+}
+
+func IsSortedInt64Ptr(slice []*int64) bool {
+	for index := 1; index < len(slice); index++ {
+		if *slice[index] < *slice[index-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64Ptr) IsSorted() bool {
+	return IsSortedInt64Ptr(c.value)
+}
+
+func SortByInt64Ptr(slice []*int64, less func(a, b *int64) bool) (res []*int64) {
+	res = make([]*int64, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainInt64Ptr) SortBy(less func(a, b *int64) bool) *chainInt64Ptr {
+	return &chainInt64Ptr{value: SortByInt64Ptr(c.value, less), err: c.err}
+}
+
+func SortStableByInt64Ptr(slice []*int64, less func(a, b *int64) bool) (res []*int64) {
+	res = make([]*int64, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainInt64Ptr) SortStableBy(less func(a, b *int64) bool) *chainInt64Ptr {
+	return &chainInt64Ptr{value: SortStableByInt64Ptr(c.value, less), err: c.err}
+}
+
+func IsSortedByInt64Ptr(slice []*int64, less func(a, b *int64) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64Ptr) IsSortedBy(less func(a, b *int64) bool) bool {
+	return IsSortedByInt64Ptr(c.value, less)
+}
+
+func UnionInt64Ptr(slice []*int64, slice2 []*int64) (res []*int64) {
+	res = []*int64{}
+	seen := make(map[int64]bool)
+	for _, entry := range slice {
+		if !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Union(slice2 []*int64) *chainInt64Ptr {
+	return &chainInt64Ptr{value: UnionInt64Ptr(c.value, slice2), err: c.err}
+}
+
+func IntersectionInt64Ptr(slice []*int64, slice2 []*int64) (res []*int64) {
+	res = []*int64{}
+	in := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[*entry] = true
+	}
+	seen := make(map[int64]bool)
+	for _, entry := range slice {
+		if in[*entry] && !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Intersection(slice2 []*int64) *chainInt64Ptr {
+	return &chainInt64Ptr{value: IntersectionInt64Ptr(c.value, slice2), err: c.err}
+}
+
+func DifferenceInt64Ptr(slice []*int64, slice2 []*int64) (res []*int64) {
+	res = []*int64{}
+	seen := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[*entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Difference(slice2 []*int64) *chainInt64Ptr {
+	return &chainInt64Ptr{value: DifferenceInt64Ptr(c.value, slice2), err: c.err}
+}
+
+func XorInt64Ptr(slice []*int64, slice2 []*int64) (res []*int64) {
+	res = []*int64{}
+	in := make(map[int64]bool, len(slice))
+	for _, entry := range slice {
+		in[*entry] = true
+	}
+	in2 := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[*entry] = true
+	}
+	seen := make(map[int64]bool)
+	for _, entry := range slice {
+		if !in2[*entry] && !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[*entry] && !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Xor(slice2 []*int64) *chainInt64Ptr {
+	return &chainInt64Ptr{value: XorInt64Ptr(c.value, slice2), err: c.err}
+}
+
+func IsSubsetInt64Ptr(slice []*int64, slice2 []*int64) bool {
+	in := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[*entry] = true
+	}
+	for _, entry := range slice {
+		if !in[*entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64Ptr) IsSubset(slice2 []*int64) bool {
+	return IsSubsetInt64Ptr(c.value, slice2)
+}
+
+func IsDisjointInt64Ptr(slice []*int64, slice2 []*int64) bool {
+	in := make(map[int64]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[*entry] = true
+	}
+	for _, entry := range slice {
+		if in[*entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64Ptr) IsDisjoint(slice2 []*int64) bool {
+	return IsDisjointInt64Ptr(c.value, slice2)
+}
+
+func PartitionInt64Ptr(slice []*int64, fn func(*int64, int) bool) (yes []*int64, no []*int64) {
+	yes = []*int64{}
+	no = []*int64{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Partition(fn func(*int64, int) bool) ([]*int64, []*int64) {
+	return PartitionInt64Ptr(c.value, fn)
+}
+
+func FindInt64Ptr(slice []*int64, fn func(*int64, int) bool) (res *int64, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Find(fn func(*int64, int) bool) (*int64, bool) {
+	return FindInt64Ptr(c.value, fn)
+}
+
+func FindIndexInt64Ptr(slice []*int64, fn func(*int64, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt64Ptr) FindIndex(fn func(*int64, int) bool) int {
+	return FindIndexInt64Ptr(c.value, fn)
+}
+
+func FindLastInt64Ptr(slice []*int64, fn func(*int64, int) bool) (res *int64, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) FindLast(fn func(*int64, int) bool) (*int64, bool) {
+	return FindLastInt64Ptr(c.value, fn)
+}
+
+func FindLastIndexInt64Ptr(slice []*int64, fn func(*int64, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt64Ptr) FindLastIndex(fn func(*int64, int) bool) int {
+	return FindLastIndexInt64Ptr(c.value, fn)
+}
+
+func IndexOfInt64Ptr(slice []*int64, item *int64) int {
+	for index, val := range slice {
+		if val == item || *val == *item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt64Ptr) IndexOf(item *int64) int {
+	return IndexOfInt64Ptr(c.value, item)
+}
+
+func LastIndexOfInt64Ptr(slice []*int64, item *int64) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item || *val == *item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt64Ptr) LastIndexOf(item *int64) int {
+	return LastIndexOfInt64Ptr(c.value, item)
+}
+
+func EveryInt64Ptr(slice []*int64, fn func(*int64, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64Ptr) Every(fn func(*int64, int) bool) bool {
+	return EveryInt64Ptr(c.value, fn)
+}
+
+func SomeInt64Ptr(slice []*int64, fn func(*int64, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainInt64Ptr) Some(fn func(*int64, int) bool) bool {
+	return SomeInt64Ptr(c.value, fn)
+}
+
+func NoneInt64Ptr(slice []*int64, fn func(*int64, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt64Ptr) None(fn func(*int64, int) bool) bool {
+	return NoneInt64Ptr(c.value, fn)
+}
+
+func CountInt64Ptr(slice []*int64, fn func(*int64, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Count(fn func(*int64, int) bool) int {
+	return CountInt64Ptr(c.value, fn)
+}
+
+func TakeInt64Ptr(slice []*int64, n int) (res []*int64) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*int64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainInt64Ptr) Take(n int) *chainInt64Ptr {
+	return &chainInt64Ptr{value: TakeInt64Ptr(c.value, n), err: c.err}
+}
+
+func TakeRightInt64Ptr(slice []*int64, n int) (res []*int64) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*int64, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainInt64Ptr) TakeRight(n int) *chainInt64Ptr {
+	return &chainInt64Ptr{value: TakeRightInt64Ptr(c.value, n), err: c.err}
+}
+
+func TakeWhileInt64Ptr(slice []*int64, fn func(*int64, int) bool) (res []*int64) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]*int64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainInt64Ptr) TakeWhile(fn func(*int64, int) bool) *chainInt64Ptr {
+	return &chainInt64Ptr{value: TakeWhileInt64Ptr(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileInt64Ptr(slice []*int64, fn func(*int64, int) bool) (res []*int64) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]*int64, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainInt64Ptr) TakeRightWhile(fn func(*int64, int) bool) *chainInt64Ptr {
+	return &chainInt64Ptr{value: TakeRightWhileInt64Ptr(c.value, fn), err: c.err}
+}
+
+func DropWhileInt64Ptr(slice []*int64, fn func(*int64, int) bool) (res []*int64) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]*int64, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainInt64Ptr) DropWhile(fn func(*int64, int) bool) *chainInt64Ptr {
+	return &chainInt64Ptr{value: DropWhileInt64Ptr(c.value, fn), err: c.err}
+}
+
+func DropRightWhileInt64Ptr(slice []*int64, fn func(*int64, int) bool) (res []*int64) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]*int64, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainInt64Ptr) DropRightWhile(fn func(*int64, int) bool) *chainInt64Ptr {
+	return &chainInt64Ptr{value: DropRightWhileInt64Ptr(c.value, fn), err: c.err}
+}
+
+func SliceInt64Ptr(slice []*int64, start int, end int) (res []*int64) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]*int64, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainInt64Ptr) Slice(start int, end int) *chainInt64Ptr {
+	return &chainInt64Ptr{value: SliceInt64Ptr(c.value, start, end), err: c.err}
+}
+
+type chainInt64PtrSlices struct {
+	value [][]*int64
+	err   error
+}
+
+func NewInt64PtrSlices(slices [][]*int64) *chainInt64PtrSlices {
+	return &chainInt64PtrSlices{value: slices}
+}
+
+func (c *chainInt64PtrSlices) Value() [][]*int64 {
+	return c.value
+}
+
+func (c *chainInt64PtrSlices) Result() ([][]*int64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainInt64PtrSlices) Each(fn func([]*int64, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainInt64PtrSlices) Map(fn func([]*int64, int) []*int64) *chainInt64PtrSlices {
+	res := make([][]*int64, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainInt64PtrSlices{value: res, err: c.err}
+}
+
+func (c *chainInt64PtrSlices) Reduce(fn func(*int64, *int64, int) *int64, initial *int64) *chainInt64Ptr {
+	res := make([]*int64, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainInt64Ptr{value: res, isPtr: true, err: c.err}
+}
+
+func ChunkInt64Ptr(slice []*int64, size int) (res [][]*int64) {
+	res = [][]*int64{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]*int64, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Chunk(size int) *chainInt64PtrSlices {
+	return &chainInt64PtrSlices{value: ChunkInt64Ptr(c.value, size), err: c.err}
+}
+
+func WindowInt64Ptr(slice []*int64, size int, step int) (res [][]*int64) {
+	res = [][]*int64{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]*int64, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Window(size int, step int) *chainInt64PtrSlices {
+	return &chainInt64PtrSlices{value: WindowInt64Ptr(c.value, size, step), err: c.err}
+}
+
+func FlattenInt64Ptr(slices [][]*int64) (res []*int64) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]*int64, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainInt64PtrSlices) Flatten() *chainInt64Ptr {
+	return &chainInt64Ptr{value: FlattenInt64Ptr(c.value), isPtr: true, err: c.err}
+}
+
+func FlatMapInt64Ptr(slice []*int64, fn func(*int64, int) []*int64) (res []*int64) {
+	mapped := make([][]*int64, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]*int64, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainInt64Ptr) FlatMap(fn func(*int64, int) []*int64) *chainInt64Ptr {
+	return &chainInt64Ptr{value: FlatMapInt64Ptr(c.value, fn), isPtr: true, err: c.err}
+}
+
+func MinInt64Ptr(slice []*int64) (res *int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if *entry < *res {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt64Ptr) Min() (*int64, bool) {
+	return MinInt64Ptr(c.value)
+}
+
+func MaxInt64Ptr(slice []*int64) (res *int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if *res < *entry {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt64Ptr) Max() (*int64, bool) {
+	return MaxInt64Ptr(c.value)
+}
+
+func MinByInt64Ptr(slice []*int64, less func(a, b *int64) bool) (res *int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt64Ptr) MinBy(less func(a, b *int64) bool) (*int64, bool) {
+	return MinByInt64Ptr(c.value, less)
+}
+
+func MaxByInt64Ptr(slice []*int64, less func(a, b *int64) bool) (res *int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt64Ptr) MaxBy(less func(a, b *int64) bool) (*int64, bool) {
+	return MaxByInt64Ptr(c.value, less)
+}
+
+func ShuffleInt64Ptr(slice []*int64, rnd interface{ Intn(int) int }) (res []*int64) {
+	res = make([]*int64, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainInt64Ptr) Shuffle(rnd interface{ Intn(int) int }) *chainInt64Ptr {
+	return &chainInt64Ptr{value: ShuffleInt64Ptr(c.value, rnd), err: c.err}
+}
+
+func SampleInt64Ptr(slice []*int64, rnd interface{ Intn(int) int }) (res *int64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainInt64Ptr) Sample(rnd interface{ Intn(int) int }) (*int64, bool) {
+	return SampleInt64Ptr(c.value, rnd)
+}
+
+func SampleNInt64Ptr(slice []*int64, n int, rnd interface{ Intn(int) int }) (res []*int64) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]*int64, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) SampleN(n int, rnd interface{ Intn(int) int }) *chainInt64Ptr {
+	return &chainInt64Ptr{value: SampleNInt64Ptr(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceInt64Ptr(slice []*int64, weight func(*int64) float64, rnd interface{ Float64() float64 }) (res *int64, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainInt64Ptr) WeightedChoice(weight func(*int64) float64, rnd interface{ Float64() float64 }) (*int64, bool) {
+	return WeightedChoiceInt64Ptr(c.value, weight, rnd)
+}
+
+// lazyInt64PtrStep is a step of a lazyInt64Ptr. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyInt64PtrStep struct {
+	start func() func(*int64) (*int64, bool, bool)
+	apply func([]*int64) []*int64
+}
+
+type lazyInt64Ptr struct {
+	isPtr  bool
+	err    error
+	source []*int64
+	steps  []lazyInt64PtrStep
+}
+
+func (c *chainInt64Ptr) Lazy() *lazyInt64Ptr {
+	return &lazyInt64Ptr{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyInt64Ptr) then(step lazyInt64PtrStep) *lazyInt64Ptr {
+	// copied, so that chains can branch from c
+	steps := make([]lazyInt64PtrStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyInt64Ptr{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyInt64Ptr) Filter(fn func(*int64, int) bool) *lazyInt64Ptr {
+	return c.then(lazyInt64PtrStep{start: func() func(*int64) (*int64, bool, bool) {
+		index := 0
+		return func(entry *int64) (*int64, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyInt64Ptr) Map(fn func(*int64, int) *int64) *lazyInt64Ptr {
+	return c.then(lazyInt64PtrStep{start: func() func(*int64) (*int64, bool, bool) {
+		index := 0
+		return func(entry *int64) (*int64, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyInt64Ptr) Drop(n int) *lazyInt64Ptr {
+	return c.then(lazyInt64PtrStep{start: func() func(*int64) (*int64, bool, bool) {
+		seen := 0
+		return func(entry *int64) (*int64, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyInt64Ptr) Take(n int) *lazyInt64Ptr {
+	return c.then(lazyInt64PtrStep{start: func() func(*int64) (*int64, bool, bool) {
+		taken := 0
+		return func(entry *int64) (*int64, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyInt64Ptr) TakeWhile(fn func(*int64, int) bool) *lazyInt64Ptr {
+	return c.then(lazyInt64PtrStep{start: func() func(*int64) (*int64, bool, bool) {
+		index := 0
+		return func(entry *int64) (*int64, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyInt64Ptr) DropWhile(fn func(*int64, int) bool) *lazyInt64Ptr {
+	return c.then(lazyInt64PtrStep{start: func() func(*int64) (*int64, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry *int64) (*int64, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyInt64Ptr) Reverse() *lazyInt64Ptr {
+	return c.then(lazyInt64PtrStep{apply: func(slice []*int64) []*int64 {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyInt64Ptr) DropRight(n int) *lazyInt64Ptr {
+	return c.then(lazyInt64PtrStep{apply: func(slice []*int64) []*int64 {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyInt64Ptr) TakeRight(n int) *lazyInt64Ptr {
+	return c.then(lazyInt64PtrStep{apply: func(slice []*int64) []*int64 {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyInt64Ptr) Value() []*int64 {
+	res := make([]*int64, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(*int64) (*int64, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyInt64Ptr) Eager() *chainInt64Ptr {
+	return &chainInt64Ptr{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelInt64Ptr calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelInt64Ptr(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachInt64Ptr(slice []*int64, fn func(*int64, int), workers int) {
+	runParallelInt64Ptr(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelInt64Ptr struct {
+	isPtr   bool
+	err     error
+	value   []*int64
+	workers int
+}
+
+func (c *chainInt64Ptr) Parallel(workers int) *parallelInt64Ptr {
+	return &parallelInt64Ptr{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelInt64Ptr) ForEach(fn func(*int64, int)) {
+	ParallelForEachInt64Ptr(c.value, fn, c.workers)
+}
+
+func (c *parallelInt64Ptr) Value() []*int64 {
+	return c.value
+}
+
+func (c *parallelInt64Ptr) Result() ([]*int64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelInt64Ptr) Sequential() *chainInt64Ptr {
+	return &chainInt64Ptr{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapInt64Ptr(slice []*int64, fn func(*int64, int) *int64, workers int) (res []*int64) {
+	res = make([]*int64, len(slice))
+	runParallelInt64Ptr(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelInt64Ptr) Map(fn func(*int64, int) *int64) *parallelInt64Ptr {
+	return &parallelInt64Ptr{value: ParallelMapInt64Ptr(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterInt64Ptr(slice []*int64, fn func(*int64, int) bool, workers int) (res []*int64) {
+	keep := make([]bool, len(slice))
+	runParallelInt64Ptr(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]*int64, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelInt64Ptr) Filter(fn func(*int64, int) bool) *parallelInt64Ptr {
+	return &parallelInt64Ptr{value: ParallelFilterInt64Ptr(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrInt64Ptr(slice []*int64, fn func(*int64, int) (*int64, error)) (res []*int64, err error) {
+	res = make([]*int64, 0, len(slice))
+	for index, entry := range slice {
+		var val *int64
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainInt64Ptr) MapErr(fn func(*int64, int) (*int64, error)) *chainInt64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrInt64Ptr(c.value, fn)
+	return &chainInt64Ptr{value: res, err: err}
+}
+
+func FilterErrInt64Ptr(slice []*int64, fn func(*int64, int) (bool, error)) (res []*int64, err error) {
+	res = make([]*int64, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt64Ptr) FilterErr(fn func(*int64, int) (bool, error)) *chainInt64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrInt64Ptr(c.value, fn)
+	return &chainInt64Ptr{value: res, err: err}
+}
+
+func ReduceErrInt64Ptr(slice []*int64, fn func(*int64, *int64, int) (*int64, error), initial *int64) (res *int64, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainInt64Ptr) ReduceErr(fn func(*int64, *int64, int) (*int64, error), initial *int64) *chainInt64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrInt64Ptr(c.value, fn, initial)
+	if err != nil {
+		return &chainInt64Ptr{err: err}
+	}
+	return &chainInt64Ptr{value: []*int64{res}}
+}
+
+func ForEachErrInt64Ptr(slice []*int64, fn func(*int64, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainInt64Ptr) ForEachErr(fn func(*int64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrInt64Ptr(c.value, fn)
+}
+
+func MapCtxInt64Ptr(ctx context.Context, slice []*int64, fn func(*int64, int) (*int64, error)) ([]*int64, error) {
+	return MapErrInt64Ptr(slice, func(entry *int64, index int) (*int64, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainInt64Ptr) MapCtx(ctx context.Context, fn func(*int64, int) (*int64, error)) *chainInt64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxInt64Ptr(ctx, c.value, fn)
+	return &chainInt64Ptr{value: res, err: err}
+}
+
+func FilterCtxInt64Ptr(ctx context.Context, slice []*int64, fn func(*int64, int) (bool, error)) ([]*int64, error) {
+	return FilterErrInt64Ptr(slice, func(entry *int64, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainInt64Ptr) FilterCtx(ctx context.Context, fn func(*int64, int) (bool, error)) *chainInt64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxInt64Ptr(ctx, c.value, fn)
+	return &chainInt64Ptr{value: res, err: err}
+}
+
+func ReduceCtxInt64Ptr(ctx context.Context, slice []*int64, fn func(*int64, *int64, int) (*int64, error), initial *int64) (*int64, error) {
+	return ReduceErrInt64Ptr(slice, func(acc *int64, entry *int64, index int) (*int64, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainInt64Ptr) ReduceCtx(ctx context.Context, fn func(*int64, *int64, int) (*int64, error), initial *int64) *chainInt64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxInt64Ptr(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainInt64Ptr{err: err}
+	}
+	return &chainInt64Ptr{value: []*int64{res}}
+}
+
+func ForEachCtxInt64Ptr(ctx context.Context, slice []*int64, fn func(*int64, int) error) error {
+	return ForEachErrInt64Ptr(slice, func(entry *int64, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainInt64Ptr) ForEachCtx(ctx context.Context, fn func(*int64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxInt64Ptr(ctx, c.value, fn)
+}
+
+func ParallelMapCtxInt64Ptr(ctx context.Context, slice []*int64, fn func(*int64, int) (*int64, error), workers int) ([]*int64, error) {
+	res := make([]*int64, len(slice))
+	err := runParallelInt64Ptr(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelInt64Ptr) MapCtx(ctx context.Context, fn func(*int64, int) (*int64, error)) *parallelInt64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxInt64Ptr(ctx, c.value, fn, c.workers)
+	return &parallelInt64Ptr{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxInt64Ptr(ctx context.Context, slice []*int64, fn func(*int64, int) (bool, error), workers int) ([]*int64, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelInt64Ptr(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*int64, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelInt64Ptr) FilterCtx(ctx context.Context, fn func(*int64, int) (bool, error)) *parallelInt64Ptr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxInt64Ptr(ctx, c.value, fn, c.workers)
+	return &parallelInt64Ptr{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxInt64Ptr(ctx context.Context, slice []*int64, fn func(*int64, int) error, workers int) error {
+	return runParallelInt64Ptr(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelInt64Ptr) ForEachCtx(ctx context.Context, fn func(*int64, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxInt64Ptr(ctx, c.value, fn, c.workers)
+}
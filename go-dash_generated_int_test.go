@@ -0,0 +1,1733 @@
+// This code is generated by https://github.com/jtyers/slice
+// DO NOT EDIT!
+
+package main
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+type chainInt struct {
+	isPtr bool
+	value []int
+	err   error
+}
+
+func NewIntSlice(slice []int) *chainInt {
+	return &chainInt{
+		value: slice,
+	}
+}
+
+func (c *chainInt) Value() []int {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainInt) Result() ([]int, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func ConcatInt(slice []int, slice2 []int) (res []int) {
+	res = make([]int, 0, len(slice)+len(slice2))
+	for _, entry := range slice {
+		res = append(res, entry)
+	}
+	for _, entry := range slice2 {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainInt) Concat(slice2 []int) *chainInt {
+	return &chainInt{value: ConcatInt(c.value, slice2), err: c.err}
+}
+
+func ContainsInt(slice []int, item int) (res bool) {
+	for _, val := range slice {
+		if val == item {
+			return true
+		}
+
+	}
+	return false
+}
+
+func (c *chainInt) Contains(item int) bool {
+	return ContainsInt(c.value, item)
+}
+
+func DropInt(slice []int, n int) (res []int) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]int, 0, l)
+	for _, entry := range slice[len(slice)-l:] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainInt) Drop(n int) *chainInt {
+	return &chainInt{value: DropInt(c.value, n), err: c.err}
+}
+
+func DropRightInt(slice []int, n int) (res []int) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]int, 0, l)
+	for _, entry := range slice[:l] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainInt) DropRight(n int) *chainInt {
+	return &chainInt{value: DropRightInt(c.value, n), err: c.err}
+}
+
+func FilterInt(slice []int, fn func(int, int) bool) (res []int) {
+	res = make([]int, 0, len(slice))
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt) Filter(fn func(int, int) bool) *chainInt {
+	return &chainInt{value: FilterInt(c.value, fn), err: c.err}
+}
+
+func FirstInt(slice []int) (res int) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	return
+}
+
+func (c *chainInt) First() *chainInt {
+	return &chainInt{value: []int{FirstInt(c.value)}, err: c.err}
+}
+
+func LastInt(slice []int) (res int) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[len(slice)-1]
+	return
+}
+
+func (c *chainInt) Last() *chainInt {
+	return &chainInt{value: []int{LastInt(c.value)}, err: c.err}
+}
+
+func MapInt(slice []int, fn func(int, int) int) (res []int) {
+	res = make([]int, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainInt) Map(fn func(int, int) int) *chainInt {
+	return &chainInt{value: MapInt(c.value, fn), err: c.err}
+}
+
+func ReduceInt(slice []int, fn func(int, int, int) int, initial int) (res int) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainInt) Reduce(fn func(int, int, int) int, initial int) *chainInt {
+	return &chainInt{value: []int{ReduceInt(c.value, fn, initial)}, err: c.err}
+}
+
+func ReverseInt(slice []int) (res []int) {
+	res = make([]int, len(slice))
+	for index, entry := range slice {
+		res[len(slice)-1-index] = entry
+	}
+	return
+}
+
+func (c *chainInt) Reverse() *chainInt {
+	return &chainInt{value: ReverseInt(c.value), err: c.err}
+}
+
+func UniqInt(slice []int) (res []int) {
+	seen := make(map[int]bool)
+	res = []int{}
+	for _, entry := range slice {
+		if _, found := seen[entry]; !found {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+
+}
+
+func (c *chainInt) Uniq() *chainInt {
+	if c.isPtr {
+		panic("Uniq() does not currently support pointers")
+	}
+	return &chainInt{value: UniqInt(c.value), err: c.err}
+}
+
+func MapIntToString(slice []int, fn func(int, int) string) (res []string) {
+	res = make([]string, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainInt) MapToString(fn func(int, int) string) *chainString {
+	return &chainString{value: MapIntToString(c.value, fn), err: c.err}
+}
+
+func ReduceIntToString(slice []int, fn func(string, int, int) string, initial string) (res string) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainInt) ReduceToString(fn func(string, int, int) string, initial string) *chainString {
+	return &chainString{value: []string{ReduceIntToString(c.value, fn, initial)}, err: c.err}
+}
+
+func SortInt(slice []int) (res []int) {
+	res = make([]int, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainInt) Sort() *chainInt {
+	return &chainInt{value: SortInt(c.value), err: c.err}
+}
+
+func SortStableInt(slice []int) (res []int) {
+	res = make([]int, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainInt) SortStable() *chainInt {
+	return &chainInt{value: SortStableInt(c.value), err: c.err}
+}
+
+func IsSortedInt(slice []int) bool {
+	for index := 1; index < len(slice); index++ {
+		if slice[index] < slice[index-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt) IsSorted() bool {
+	return IsSortedInt(c.value)
+}
+
+func SortByInt(slice []int, less func(a, b int) bool) (res []int) {
+	res = make([]int, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainInt) SortBy(less func(a, b int) bool) *chainInt {
+	return &chainInt{value: SortByInt(c.value, less), err: c.err}
+}
+
+func SortStableByInt(slice []int, less func(a, b int) bool) (res []int) {
+	res = make([]int, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainInt) SortStableBy(less func(a, b int) bool) *chainInt {
+	return &chainInt{value: SortStableByInt(c.value, less), err: c.err}
+}
+
+func IsSortedByInt(slice []int, less func(a, b int) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt) IsSortedBy(less func(a, b int) bool) bool {
+	return IsSortedByInt(c.value, less)
+}
+
+func UnionInt(slice []int, slice2 []int) (res []int) {
+	res = []int{}
+	seen := make(map[int]bool)
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt) Union(slice2 []int) *chainInt {
+	return &chainInt{value: UnionInt(c.value, slice2), err: c.err}
+}
+
+func IntersectionInt(slice []int, slice2 []int) (res []int) {
+	res = []int{}
+	in := make(map[int]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	seen := make(map[int]bool)
+	for _, entry := range slice {
+		if in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt) Intersection(slice2 []int) *chainInt {
+	return &chainInt{value: IntersectionInt(c.value, slice2), err: c.err}
+}
+
+func DifferenceInt(slice []int, slice2 []int) (res []int) {
+	res = []int{}
+	seen := make(map[int]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt) Difference(slice2 []int) *chainInt {
+	return &chainInt{value: DifferenceInt(c.value, slice2), err: c.err}
+}
+
+func XorInt(slice []int, slice2 []int) (res []int) {
+	res = []int{}
+	in := make(map[int]bool, len(slice))
+	for _, entry := range slice {
+		in[entry] = true
+	}
+	in2 := make(map[int]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[entry] = true
+	}
+	seen := make(map[int]bool)
+	for _, entry := range slice {
+		if !in2[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt) Xor(slice2 []int) *chainInt {
+	return &chainInt{value: XorInt(c.value, slice2), err: c.err}
+}
+
+func IsSubsetInt(slice []int, slice2 []int) bool {
+	in := make(map[int]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if !in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt) IsSubset(slice2 []int) bool {
+	return IsSubsetInt(c.value, slice2)
+}
+
+func IsDisjointInt(slice []int, slice2 []int) bool {
+	in := make(map[int]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt) IsDisjoint(slice2 []int) bool {
+	return IsDisjointInt(c.value, slice2)
+}
+
+func PartitionInt(slice []int, fn func(int, int) bool) (yes []int, no []int) {
+	yes = []int{}
+	no = []int{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt) Partition(fn func(int, int) bool) ([]int, []int) {
+	return PartitionInt(c.value, fn)
+}
+
+func FindInt(slice []int, fn func(int, int) bool) (res int, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainInt) Find(fn func(int, int) bool) (int, bool) {
+	return FindInt(c.value, fn)
+}
+
+func FindIndexInt(slice []int, fn func(int, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt) FindIndex(fn func(int, int) bool) int {
+	return FindIndexInt(c.value, fn)
+}
+
+func FindLastInt(slice []int, fn func(int, int) bool) (res int, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainInt) FindLast(fn func(int, int) bool) (int, bool) {
+	return FindLastInt(c.value, fn)
+}
+
+func FindLastIndexInt(slice []int, fn func(int, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt) FindLastIndex(fn func(int, int) bool) int {
+	return FindLastIndexInt(c.value, fn)
+}
+
+func IndexOfInt(slice []int, item int) int {
+	for index, val := range slice {
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt) IndexOf(item int) int {
+	return IndexOfInt(c.value, item)
+}
+
+func LastIndexOfInt(slice []int, item int) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainInt) LastIndexOf(item int) int {
+	return LastIndexOfInt(c.value, item)
+}
+
+func EveryInt(slice []int, fn func(int, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt) Every(fn func(int, int) bool) bool {
+	return EveryInt(c.value, fn)
+}
+
+func SomeInt(slice []int, fn func(int, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainInt) Some(fn func(int, int) bool) bool {
+	return SomeInt(c.value, fn)
+}
+
+func NoneInt(slice []int, fn func(int, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainInt) None(fn func(int, int) bool) bool {
+	return NoneInt(c.value, fn)
+}
+
+func CountInt(slice []int, fn func(int, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainInt) Count(fn func(int, int) bool) int {
+	return CountInt(c.value, fn)
+}
+
+func TakeInt(slice []int, n int) (res []int) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]int, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainInt) Take(n int) *chainInt {
+	return &chainInt{value: TakeInt(c.value, n), err: c.err}
+}
+
+func TakeRightInt(slice []int, n int) (res []int) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]int, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainInt) TakeRight(n int) *chainInt {
+	return &chainInt{value: TakeRightInt(c.value, n), err: c.err}
+}
+
+func TakeWhileInt(slice []int, fn func(int, int) bool) (res []int) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]int, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainInt) TakeWhile(fn func(int, int) bool) *chainInt {
+	return &chainInt{value: TakeWhileInt(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileInt(slice []int, fn func(int, int) bool) (res []int) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]int, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainInt) TakeRightWhile(fn func(int, int) bool) *chainInt {
+	return &chainInt{value: TakeRightWhileInt(c.value, fn), err: c.err}
+}
+
+func DropWhileInt(slice []int, fn func(int, int) bool) (res []int) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]int, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainInt) DropWhile(fn func(int, int) bool) *chainInt {
+	return &chainInt{value: DropWhileInt(c.value, fn), err: c.err}
+}
+
+func DropRightWhileInt(slice []int, fn func(int, int) bool) (res []int) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]int, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainInt) DropRightWhile(fn func(int, int) bool) *chainInt {
+	return &chainInt{value: DropRightWhileInt(c.value, fn), err: c.err}
+}
+
+func SliceInt(slice []int, start int, end int) (res []int) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]int, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainInt) Slice(start int, end int) *chainInt {
+	return &chainInt{value: SliceInt(c.value, start, end), err: c.err}
+}
+
+type chainIntSlices struct {
+	value [][]int
+	err   error
+}
+
+func NewIntSlices(slices [][]int) *chainIntSlices {
+	return &chainIntSlices{value: slices}
+}
+
+func (c *chainIntSlices) Value() [][]int {
+	return c.value
+}
+
+func (c *chainIntSlices) Result() ([][]int, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainIntSlices) Each(fn func([]int, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainIntSlices) Map(fn func([]int, int) []int) *chainIntSlices {
+	res := make([][]int, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainIntSlices{value: res, err: c.err}
+}
+
+func (c *chainIntSlices) Reduce(fn func(int, int, int) int, initial int) *chainInt {
+	res := make([]int, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainInt{value: res, err: c.err}
+}
+
+func ChunkInt(slice []int, size int) (res [][]int) {
+	res = [][]int{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]int, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainInt) Chunk(size int) *chainIntSlices {
+	return &chainIntSlices{value: ChunkInt(c.value, size), err: c.err}
+}
+
+func WindowInt(slice []int, size int, step int) (res [][]int) {
+	res = [][]int{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]int, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainInt) Window(size int, step int) *chainIntSlices {
+	return &chainIntSlices{value: WindowInt(c.value, size, step), err: c.err}
+}
+
+type PairIntString struct {
+	First  int
+	Second string
+}
+
+type chainPairIntString struct {
+	value []PairIntString
+	err   error
+}
+
+func NewPairIntStringSlice(slice []PairIntString) *chainPairIntString {
+	return &chainPairIntString{value: slice}
+}
+
+func (c *chainPairIntString) Value() []PairIntString {
+	return c.value
+}
+
+func (c *chainPairIntString) Result() ([]PairIntString, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainPairIntString) Filter(fn func(PairIntString, int) bool) *chainPairIntString {
+	res := make([]PairIntString, 0, len(c.value))
+	for index, entry := range c.value {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return &chainPairIntString{value: res, err: c.err}
+}
+
+func ZipIntString(slice []int, slice2 []string) (res []PairIntString) {
+	l := len(slice)
+	if len(slice2) < l {
+		l = len(slice2)
+	}
+	res = make([]PairIntString, l)
+	for index := range res {
+		res[index] = PairIntString{First: slice[index], Second: slice2[index]}
+	}
+	return
+}
+
+func (c *chainInt) ZipString(slice2 []string) *chainPairIntString {
+	return &chainPairIntString{value: ZipIntString(c.value, slice2), err: c.err}
+}
+
+type PairIntStringPtr struct {
+	First  int
+	Second *string
+}
+
+type chainPairIntStringPtr struct {
+	value []PairIntStringPtr
+	err   error
+}
+
+func NewPairIntStringPtrSlice(slice []PairIntStringPtr) *chainPairIntStringPtr {
+	return &chainPairIntStringPtr{value: slice}
+}
+
+func (c *chainPairIntStringPtr) Value() []PairIntStringPtr {
+	return c.value
+}
+
+func (c *chainPairIntStringPtr) Result() ([]PairIntStringPtr, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainPairIntStringPtr) Filter(fn func(PairIntStringPtr, int) bool) *chainPairIntStringPtr {
+	res := make([]PairIntStringPtr, 0, len(c.value))
+	for index, entry := range c.value {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return &chainPairIntStringPtr{value: res, err: c.err}
+}
+
+func ZipIntStringPtr(slice []int, slice2 []*string) (res []PairIntStringPtr) {
+	l := len(slice)
+	if len(slice2) < l {
+		l = len(slice2)
+	}
+	res = make([]PairIntStringPtr, l)
+	for index := range res {
+		res[index] = PairIntStringPtr{First: slice[index], Second: slice2[index]}
+	}
+	return
+}
+
+func (c *chainInt) ZipStringPtr(slice2 []*string) *chainPairIntStringPtr {
+	return &chainPairIntStringPtr{value: ZipIntStringPtr(c.value, slice2), err: c.err}
+}
+
+func UnzipIntString(pairs []PairIntString) (res []int, res2 []string) {
+	res = make([]int, len(pairs))
+	res2 = make([]string, len(pairs))
+	for index, pair := range pairs {
+		res[index] = pair.First
+		res2[index] = pair.Second
+	}
+	return
+}
+
+func (c *chainPairIntString) Unzip() (*chainInt, *chainString) {
+	res, res2 := UnzipIntString(c.value)
+	return &chainInt{value: res, err: c.err}, &chainString{value: res2, err: c.err}
+}
+
+func UnzipIntStringPtr(pairs []PairIntStringPtr) (res []int, res2 []*string) {
+	res = make([]int, len(pairs))
+	res2 = make([]*string, len(pairs))
+	for index, pair := range pairs {
+		res[index] = pair.First
+		res2[index] = pair.Second
+	}
+	return
+}
+
+func (c *chainPairIntStringPtr) Unzip() (*chainInt, *chainStringPtr) {
+	res, res2 := UnzipIntStringPtr(c.value)
+	return &chainInt{value: res, err: c.err}, &chainStringPtr{value: res2, isPtr: true, err: c.err}
+}
+
+func FlattenInt(slices [][]int) (res []int) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]int, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainIntSlices) Flatten() *chainInt {
+	return &chainInt{value: FlattenInt(c.value), err: c.err}
+}
+
+func FlatMapInt(slice []int, fn func(int, int) []int) (res []int) {
+	mapped := make([][]int, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]int, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainInt) FlatMap(fn func(int, int) []int) *chainInt {
+	return &chainInt{value: FlatMapInt(c.value, fn), err: c.err}
+}
+
+func SumInt(slice []int) (res int) {
+	for _, entry := range slice {
+		res += entry
+	}
+	return
+}
+
+func (c *chainInt) Sum() int {
+	return SumInt(c.value)
+}
+
+func SumCheckedInt(slice []int) (res int, ok bool) {
+	for _, entry := range slice {
+		sum := res + entry
+		if (entry > 0 && sum < res) || (entry < 0 && sum > res) {
+			return 0, false
+		}
+		res = sum
+	}
+	return res, true
+}
+
+func (c *chainInt) SumChecked() (int, bool) {
+	return SumCheckedInt(c.value)
+}
+
+func ProductInt(slice []int) (res int) {
+	res = 1
+	for _, entry := range slice {
+		res *= entry
+	}
+	return
+}
+
+func (c *chainInt) Product() int {
+	return ProductInt(c.value)
+}
+
+func MinInt(slice []int) (res int, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if entry < res {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt) Min() (int, bool) {
+	return MinInt(c.value)
+}
+
+func MaxInt(slice []int) (res int, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if res < entry {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt) Max() (int, bool) {
+	return MaxInt(c.value)
+}
+
+func MinByInt(slice []int, less func(a, b int) bool) (res int, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt) MinBy(less func(a, b int) bool) (int, bool) {
+	return MinByInt(c.value, less)
+}
+
+func MaxByInt(slice []int, less func(a, b int) bool) (res int, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainInt) MaxBy(less func(a, b int) bool) (int, bool) {
+	return MaxByInt(c.value, less)
+}
+
+func MeanInt(slice []int) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	for _, entry := range slice {
+		res += float64(entry)
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainInt) Mean() (float64, bool) {
+	return MeanInt(c.value)
+}
+
+func MedianInt(slice []int) (float64, bool) {
+	return PercentileInt(slice, 50)
+}
+
+func (c *chainInt) Median() (float64, bool) {
+	return MedianInt(c.value)
+}
+
+func PercentileInt(slice []int, p float64) (res float64, ok bool) {
+	if len(slice) == 0 || !(p >= 0 && p <= 100) {
+		return
+	}
+
+	sorted := make([]float64, len(slice))
+	for index, entry := range slice {
+		sorted[index] = float64(entry)
+	}
+	sort.Float64s(sorted)
+
+	// interpolate between the closest ranks
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower == len(sorted)-1 {
+		return sorted[lower], true
+	}
+	return sorted[lower] + (sorted[lower+1]-sorted[lower])*(rank-float64(lower)), true
+}
+
+func (c *chainInt) Percentile(p float64) (float64, bool) {
+	return PercentileInt(c.value, p)
+}
+
+func VarianceInt(slice []int) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+
+	mean := 0.0
+	for _, entry := range slice {
+		mean += float64(entry)
+	}
+	mean /= float64(len(slice))
+
+	for _, entry := range slice {
+		d := float64(entry) - mean
+		res += d * d
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainInt) Variance() (float64, bool) {
+	return VarianceInt(c.value)
+}
+
+func StdDevInt(slice []int) (float64, bool) {
+	variance, ok := VarianceInt(slice)
+	return math.Sqrt(variance), ok
+}
+
+func (c *chainInt) StdDev() (float64, bool) {
+	return StdDevInt(c.value)
+}
+
+func ShuffleInt(slice []int, rnd interface{ Intn(int) int }) (res []int) {
+	res = make([]int, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainInt) Shuffle(rnd interface{ Intn(int) int }) *chainInt {
+	return &chainInt{value: ShuffleInt(c.value, rnd), err: c.err}
+}
+
+func SampleInt(slice []int, rnd interface{ Intn(int) int }) (res int, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainInt) Sample(rnd interface{ Intn(int) int }) (int, bool) {
+	return SampleInt(c.value, rnd)
+}
+
+func SampleNInt(slice []int, n int, rnd interface{ Intn(int) int }) (res []int) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]int, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainInt) SampleN(n int, rnd interface{ Intn(int) int }) *chainInt {
+	return &chainInt{value: SampleNInt(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceInt(slice []int, weight func(int) float64, rnd interface{ Float64() float64 }) (res int, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainInt) WeightedChoice(weight func(int) float64, rnd interface{ Float64() float64 }) (int, bool) {
+	return WeightedChoiceInt(c.value, weight, rnd)
+}
+
+// lazyIntStep is a step of a lazyInt. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyIntStep struct {
+	start func() func(int) (int, bool, bool)
+	apply func([]int) []int
+}
+
+type lazyInt struct {
+	isPtr  bool
+	err    error
+	source []int
+	steps  []lazyIntStep
+}
+
+func (c *chainInt) Lazy() *lazyInt {
+	return &lazyInt{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyInt) then(step lazyIntStep) *lazyInt {
+	// copied, so that chains can branch from c
+	steps := make([]lazyIntStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyInt{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyInt) Filter(fn func(int, int) bool) *lazyInt {
+	return c.then(lazyIntStep{start: func() func(int) (int, bool, bool) {
+		index := 0
+		return func(entry int) (int, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyInt) Map(fn func(int, int) int) *lazyInt {
+	return c.then(lazyIntStep{start: func() func(int) (int, bool, bool) {
+		index := 0
+		return func(entry int) (int, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyInt) Drop(n int) *lazyInt {
+	return c.then(lazyIntStep{start: func() func(int) (int, bool, bool) {
+		seen := 0
+		return func(entry int) (int, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyInt) Take(n int) *lazyInt {
+	return c.then(lazyIntStep{start: func() func(int) (int, bool, bool) {
+		taken := 0
+		return func(entry int) (int, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyInt) TakeWhile(fn func(int, int) bool) *lazyInt {
+	return c.then(lazyIntStep{start: func() func(int) (int, bool, bool) {
+		index := 0
+		return func(entry int) (int, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyInt) DropWhile(fn func(int, int) bool) *lazyInt {
+	return c.then(lazyIntStep{start: func() func(int) (int, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry int) (int, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyInt) Reverse() *lazyInt {
+	return c.then(lazyIntStep{apply: func(slice []int) []int {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyInt) DropRight(n int) *lazyInt {
+	return c.then(lazyIntStep{apply: func(slice []int) []int {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyInt) TakeRight(n int) *lazyInt {
+	return c.then(lazyIntStep{apply: func(slice []int) []int {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyInt) Value() []int {
+	res := make([]int, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(int) (int, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyInt) Eager() *chainInt {
+	return &chainInt{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelInt calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelInt(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachInt(slice []int, fn func(int, int), workers int) {
+	runParallelInt(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelInt struct {
+	isPtr   bool
+	err     error
+	value   []int
+	workers int
+}
+
+func (c *chainInt) Parallel(workers int) *parallelInt {
+	return &parallelInt{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelInt) ForEach(fn func(int, int)) {
+	ParallelForEachInt(c.value, fn, c.workers)
+}
+
+func (c *parallelInt) Value() []int {
+	return c.value
+}
+
+func (c *parallelInt) Result() ([]int, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelInt) Sequential() *chainInt {
+	return &chainInt{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapInt(slice []int, fn func(int, int) int, workers int) (res []int) {
+	res = make([]int, len(slice))
+	runParallelInt(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelInt) Map(fn func(int, int) int) *parallelInt {
+	return &parallelInt{value: ParallelMapInt(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterInt(slice []int, fn func(int, int) bool, workers int) (res []int) {
+	keep := make([]bool, len(slice))
+	runParallelInt(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]int, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelInt) Filter(fn func(int, int) bool) *parallelInt {
+	return &parallelInt{value: ParallelFilterInt(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrInt(slice []int, fn func(int, int) (int, error)) (res []int, err error) {
+	res = make([]int, 0, len(slice))
+	for index, entry := range slice {
+		var val int
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainInt) MapErr(fn func(int, int) (int, error)) *chainInt {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrInt(c.value, fn)
+	return &chainInt{value: res, err: err}
+}
+
+func FilterErrInt(slice []int, fn func(int, int) (bool, error)) (res []int, err error) {
+	res = make([]int, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainInt) FilterErr(fn func(int, int) (bool, error)) *chainInt {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrInt(c.value, fn)
+	return &chainInt{value: res, err: err}
+}
+
+func ReduceErrInt(slice []int, fn func(int, int, int) (int, error), initial int) (res int, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainInt) ReduceErr(fn func(int, int, int) (int, error), initial int) *chainInt {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrInt(c.value, fn, initial)
+	if err != nil {
+		return &chainInt{err: err}
+	}
+	return &chainInt{value: []int{res}}
+}
+
+func ForEachErrInt(slice []int, fn func(int, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainInt) ForEachErr(fn func(int, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrInt(c.value, fn)
+}
+
+func MapCtxInt(ctx context.Context, slice []int, fn func(int, int) (int, error)) ([]int, error) {
+	return MapErrInt(slice, func(entry int, index int) (int, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainInt) MapCtx(ctx context.Context, fn func(int, int) (int, error)) *chainInt {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxInt(ctx, c.value, fn)
+	return &chainInt{value: res, err: err}
+}
+
+func FilterCtxInt(ctx context.Context, slice []int, fn func(int, int) (bool, error)) ([]int, error) {
+	return FilterErrInt(slice, func(entry int, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainInt) FilterCtx(ctx context.Context, fn func(int, int) (bool, error)) *chainInt {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxInt(ctx, c.value, fn)
+	return &chainInt{value: res, err: err}
+}
+
+func ReduceCtxInt(ctx context.Context, slice []int, fn func(int, int, int) (int, error), initial int) (int, error) {
+	return ReduceErrInt(slice, func(acc int, entry int, index int) (int, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainInt) ReduceCtx(ctx context.Context, fn func(int, int, int) (int, error), initial int) *chainInt {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxInt(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainInt{err: err}
+	}
+	return &chainInt{value: []int{res}}
+}
+
+func ForEachCtxInt(ctx context.Context, slice []int, fn func(int, int) error) error {
+	return ForEachErrInt(slice, func(entry int, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainInt) ForEachCtx(ctx context.Context, fn func(int, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxInt(ctx, c.value, fn)
+}
+
+func ParallelMapCtxInt(ctx context.Context, slice []int, fn func(int, int) (int, error), workers int) ([]int, error) {
+	res := make([]int, len(slice))
+	err := runParallelInt(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelInt) MapCtx(ctx context.Context, fn func(int, int) (int, error)) *parallelInt {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxInt(ctx, c.value, fn, c.workers)
+	return &parallelInt{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxInt(ctx context.Context, slice []int, fn func(int, int) (bool, error), workers int) ([]int, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelInt(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]int, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelInt) FilterCtx(ctx context.Context, fn func(int, int) (bool, error)) *parallelInt {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxInt(ctx, c.value, fn, c.workers)
+	return &parallelInt{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxInt(ctx context.Context, slice []int, fn func(int, int) error, workers int) error {
+	return runParallelInt(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelInt) ForEachCtx(ctx context.Context, fn func(int, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxInt(ctx, c.value, fn, c.workers)
+}
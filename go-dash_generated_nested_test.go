@@ -0,0 +1,296 @@
+// This code is generated by https://github.com/jtyers/slice
+// DO NOT EDIT!
+
+package main
+
+import (
+	. "github.com/jtyers/slice/customtype"
+)
+
+type chainRow struct {
+	isPtr bool
+	value []Row
+	err   error
+}
+
+func NewRowSlice(slice []Row) *chainRow {
+	return &chainRow{
+		value: slice,
+	}
+}
+
+func (c *chainRow) Value() []Row {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainRow) Result() ([]Row, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+type chainRowSlices struct {
+	value [][]Row
+	err   error
+}
+
+func NewRowSlices(slices [][]Row) *chainRowSlices {
+	return &chainRowSlices{value: slices}
+}
+
+func (c *chainRowSlices) Value() [][]Row {
+	return c.value
+}
+
+func (c *chainRowSlices) Result() ([][]Row, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainRowSlices) Each(fn func([]Row, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainRowSlices) Map(fn func([]Row, int) []Row) *chainRowSlices {
+	res := make([][]Row, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainRowSlices{value: res, err: c.err}
+}
+
+func (c *chainRowSlices) Reduce(fn func(Row, Row, int) Row, initial Row) *chainRow {
+	res := make([]Row, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainRow{value: res, err: c.err}
+}
+
+func ChunkRow(slice []Row, size int) (res [][]Row) {
+	res = [][]Row{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]Row, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainRow) Chunk(size int) *chainRowSlices {
+	return &chainRowSlices{value: ChunkRow(c.value, size), err: c.err}
+}
+
+func FlattenRow(slices [][]Row) (res []Row) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]Row, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainRowSlices) Flatten() *chainRow {
+	return &chainRow{value: FlattenRow(c.value), err: c.err}
+}
+
+func FlattenDeepRow(slice []Row) (res []int) {
+	l := 0
+	for _, inner := range slice {
+		l += len(inner)
+	}
+	res = make([]int, 0, l)
+	for _, inner := range slice {
+		res = append(res, inner...)
+	}
+	return
+}
+
+func (c *chainRow) FlattenDeep() []int {
+	return FlattenDeepRow(c.value)
+}
+
+func FlatMapRow(slice []Row, fn func(Row, int) []Row) (res []Row) {
+	mapped := make([][]Row, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]Row, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainRow) FlatMap(fn func(Row, int) []Row) *chainRow {
+	return &chainRow{value: FlatMapRow(c.value, fn), err: c.err}
+}
+
+type chainGrid struct {
+	isPtr bool
+	value []Grid
+	err   error
+}
+
+func NewGridSlice(slice []Grid) *chainGrid {
+	return &chainGrid{
+		value: slice,
+	}
+}
+
+func (c *chainGrid) Value() []Grid {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainGrid) Result() ([]Grid, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+type chainGridSlices struct {
+	value [][]Grid
+	err   error
+}
+
+func NewGridSlices(slices [][]Grid) *chainGridSlices {
+	return &chainGridSlices{value: slices}
+}
+
+func (c *chainGridSlices) Value() [][]Grid {
+	return c.value
+}
+
+func (c *chainGridSlices) Result() ([][]Grid, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainGridSlices) Each(fn func([]Grid, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainGridSlices) Map(fn func([]Grid, int) []Grid) *chainGridSlices {
+	res := make([][]Grid, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainGridSlices{value: res, err: c.err}
+}
+
+func (c *chainGridSlices) Reduce(fn func(Grid, Grid, int) Grid, initial Grid) *chainGrid {
+	res := make([]Grid, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainGrid{value: res, err: c.err}
+}
+
+func ChunkGrid(slice []Grid, size int) (res [][]Grid) {
+	res = [][]Grid{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]Grid, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainGrid) Chunk(size int) *chainGridSlices {
+	return &chainGridSlices{value: ChunkGrid(c.value, size), err: c.err}
+}
+
+func FlattenGrid(slices [][]Grid) (res []Grid) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]Grid, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainGridSlices) Flatten() *chainGrid {
+	return &chainGrid{value: FlattenGrid(c.value), err: c.err}
+}
+
+func FlattenDeepGrid(slice []Grid) (res []int) {
+	l := 0
+	for _, v0 := range slice {
+		for _, inner := range v0 {
+			l += len(inner)
+		}
+	}
+	res = make([]int, 0, l)
+	for _, v0 := range slice {
+		for _, inner := range v0 {
+			res = append(res, inner...)
+		}
+	}
+	return
+}
+
+func (c *chainGrid) FlattenDeep() []int {
+	return FlattenDeepGrid(c.value)
+}
+
+func FlatMapGrid(slice []Grid, fn func(Grid, int) []Grid) (res []Grid) {
+	mapped := make([][]Grid, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]Grid, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainGrid) FlatMap(fn func(Grid, int) []Grid) *chainGrid {
+	return &chainGrid{value: FlatMapGrid(c.value, fn), err: c.err}
+}
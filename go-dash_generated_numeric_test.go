@@ -0,0 +1,3143 @@
+// This code is generated by https://github.com/jtyers/slice
+// DO NOT EDIT!
+
+package main
+
+import (
+	"context"
+	. "github.com/jtyers/slice/customtype"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+type chainCents struct {
+	isPtr bool
+	value []Cents
+	err   error
+}
+
+func NewCentsSlice(slice []Cents) *chainCents {
+	return &chainCents{
+		value: slice,
+	}
+}
+
+func (c *chainCents) Value() []Cents {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainCents) Result() ([]Cents, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func ConcatCents(slice []Cents, slice2 []Cents) (res []Cents) {
+	res = make([]Cents, 0, len(slice)+len(slice2))
+	for _, entry := range slice {
+		res = append(res, entry)
+	}
+	for _, entry := range slice2 {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainCents) Concat(slice2 []Cents) *chainCents {
+	return &chainCents{value: ConcatCents(c.value, slice2), err: c.err}
+}
+
+func ContainsCents(slice []Cents, item Cents) (res bool) {
+	for _, val := range slice {
+		if val == item {
+			return true
+		}
+
+	}
+	return false
+}
+
+func (c *chainCents) Contains(item Cents) bool {
+	return ContainsCents(c.value, item)
+}
+
+func DropCents(slice []Cents, n int) (res []Cents) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]Cents, 0, l)
+	for _, entry := range slice[len(slice)-l:] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainCents) Drop(n int) *chainCents {
+	return &chainCents{value: DropCents(c.value, n), err: c.err}
+}
+
+func DropRightCents(slice []Cents, n int) (res []Cents) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]Cents, 0, l)
+	for _, entry := range slice[:l] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainCents) DropRight(n int) *chainCents {
+	return &chainCents{value: DropRightCents(c.value, n), err: c.err}
+}
+
+func FilterCents(slice []Cents, fn func(Cents, int) bool) (res []Cents) {
+	res = make([]Cents, 0, len(slice))
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCents) Filter(fn func(Cents, int) bool) *chainCents {
+	return &chainCents{value: FilterCents(c.value, fn), err: c.err}
+}
+
+func FirstCents(slice []Cents) (res Cents) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	return
+}
+
+func (c *chainCents) First() *chainCents {
+	return &chainCents{value: []Cents{FirstCents(c.value)}, err: c.err}
+}
+
+func LastCents(slice []Cents) (res Cents) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[len(slice)-1]
+	return
+}
+
+func (c *chainCents) Last() *chainCents {
+	return &chainCents{value: []Cents{LastCents(c.value)}, err: c.err}
+}
+
+func MapCents(slice []Cents, fn func(Cents, int) Cents) (res []Cents) {
+	res = make([]Cents, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainCents) Map(fn func(Cents, int) Cents) *chainCents {
+	return &chainCents{value: MapCents(c.value, fn), err: c.err}
+}
+
+func ReduceCents(slice []Cents, fn func(Cents, Cents, int) Cents, initial Cents) (res Cents) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainCents) Reduce(fn func(Cents, Cents, int) Cents, initial Cents) *chainCents {
+	return &chainCents{value: []Cents{ReduceCents(c.value, fn, initial)}, err: c.err}
+}
+
+func ReverseCents(slice []Cents) (res []Cents) {
+	res = make([]Cents, len(slice))
+	for index, entry := range slice {
+		res[len(slice)-1-index] = entry
+	}
+	return
+}
+
+func (c *chainCents) Reverse() *chainCents {
+	return &chainCents{value: ReverseCents(c.value), err: c.err}
+}
+
+func UniqCents(slice []Cents) (res []Cents) {
+	seen := make(map[Cents]bool)
+	res = []Cents{}
+	for _, entry := range slice {
+		if _, found := seen[entry]; !found {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+
+}
+
+func (c *chainCents) Uniq() *chainCents {
+	if c.isPtr {
+		panic("Uniq() does not currently support pointers")
+	}
+	return &chainCents{value: UniqCents(c.value), err: c.err}
+}
+
+func SortCents(slice []Cents) (res []Cents) {
+	res = make([]Cents, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainCents) Sort() *chainCents {
+	return &chainCents{value: SortCents(c.value), err: c.err}
+}
+
+func SortStableCents(slice []Cents) (res []Cents) {
+	res = make([]Cents, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainCents) SortStable() *chainCents {
+	return &chainCents{value: SortStableCents(c.value), err: c.err}
+}
+
+func IsSortedCents(slice []Cents) bool {
+	for index := 1; index < len(slice); index++ {
+		if slice[index] < slice[index-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCents) IsSorted() bool {
+	return IsSortedCents(c.value)
+}
+
+func SortByCents(slice []Cents, less func(a, b Cents) bool) (res []Cents) {
+	res = make([]Cents, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainCents) SortBy(less func(a, b Cents) bool) *chainCents {
+	return &chainCents{value: SortByCents(c.value, less), err: c.err}
+}
+
+func SortStableByCents(slice []Cents, less func(a, b Cents) bool) (res []Cents) {
+	res = make([]Cents, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainCents) SortStableBy(less func(a, b Cents) bool) *chainCents {
+	return &chainCents{value: SortStableByCents(c.value, less), err: c.err}
+}
+
+func IsSortedByCents(slice []Cents, less func(a, b Cents) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCents) IsSortedBy(less func(a, b Cents) bool) bool {
+	return IsSortedByCents(c.value, less)
+}
+
+func UnionCents(slice []Cents, slice2 []Cents) (res []Cents) {
+	res = []Cents{}
+	seen := make(map[Cents]bool)
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCents) Union(slice2 []Cents) *chainCents {
+	return &chainCents{value: UnionCents(c.value, slice2), err: c.err}
+}
+
+func IntersectionCents(slice []Cents, slice2 []Cents) (res []Cents) {
+	res = []Cents{}
+	in := make(map[Cents]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	seen := make(map[Cents]bool)
+	for _, entry := range slice {
+		if in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCents) Intersection(slice2 []Cents) *chainCents {
+	return &chainCents{value: IntersectionCents(c.value, slice2), err: c.err}
+}
+
+func DifferenceCents(slice []Cents, slice2 []Cents) (res []Cents) {
+	res = []Cents{}
+	seen := make(map[Cents]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCents) Difference(slice2 []Cents) *chainCents {
+	return &chainCents{value: DifferenceCents(c.value, slice2), err: c.err}
+}
+
+func XorCents(slice []Cents, slice2 []Cents) (res []Cents) {
+	res = []Cents{}
+	in := make(map[Cents]bool, len(slice))
+	for _, entry := range slice {
+		in[entry] = true
+	}
+	in2 := make(map[Cents]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[entry] = true
+	}
+	seen := make(map[Cents]bool)
+	for _, entry := range slice {
+		if !in2[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCents) Xor(slice2 []Cents) *chainCents {
+	return &chainCents{value: XorCents(c.value, slice2), err: c.err}
+}
+
+func IsSubsetCents(slice []Cents, slice2 []Cents) bool {
+	in := make(map[Cents]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if !in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCents) IsSubset(slice2 []Cents) bool {
+	return IsSubsetCents(c.value, slice2)
+}
+
+func IsDisjointCents(slice []Cents, slice2 []Cents) bool {
+	in := make(map[Cents]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCents) IsDisjoint(slice2 []Cents) bool {
+	return IsDisjointCents(c.value, slice2)
+}
+
+func PartitionCents(slice []Cents, fn func(Cents, int) bool) (yes []Cents, no []Cents) {
+	yes = []Cents{}
+	no = []Cents{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCents) Partition(fn func(Cents, int) bool) ([]Cents, []Cents) {
+	return PartitionCents(c.value, fn)
+}
+
+func FindCents(slice []Cents, fn func(Cents, int) bool) (res Cents, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainCents) Find(fn func(Cents, int) bool) (Cents, bool) {
+	return FindCents(c.value, fn)
+}
+
+func FindIndexCents(slice []Cents, fn func(Cents, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainCents) FindIndex(fn func(Cents, int) bool) int {
+	return FindIndexCents(c.value, fn)
+}
+
+func FindLastCents(slice []Cents, fn func(Cents, int) bool) (res Cents, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainCents) FindLast(fn func(Cents, int) bool) (Cents, bool) {
+	return FindLastCents(c.value, fn)
+}
+
+func FindLastIndexCents(slice []Cents, fn func(Cents, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainCents) FindLastIndex(fn func(Cents, int) bool) int {
+	return FindLastIndexCents(c.value, fn)
+}
+
+func IndexOfCents(slice []Cents, item Cents) int {
+	for index, val := range slice {
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainCents) IndexOf(item Cents) int {
+	return IndexOfCents(c.value, item)
+}
+
+func LastIndexOfCents(slice []Cents, item Cents) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainCents) LastIndexOf(item Cents) int {
+	return LastIndexOfCents(c.value, item)
+}
+
+func EveryCents(slice []Cents, fn func(Cents, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCents) Every(fn func(Cents, int) bool) bool {
+	return EveryCents(c.value, fn)
+}
+
+func SomeCents(slice []Cents, fn func(Cents, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainCents) Some(fn func(Cents, int) bool) bool {
+	return SomeCents(c.value, fn)
+}
+
+func NoneCents(slice []Cents, fn func(Cents, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainCents) None(fn func(Cents, int) bool) bool {
+	return NoneCents(c.value, fn)
+}
+
+func CountCents(slice []Cents, fn func(Cents, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainCents) Count(fn func(Cents, int) bool) int {
+	return CountCents(c.value, fn)
+}
+
+func TakeCents(slice []Cents, n int) (res []Cents) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]Cents, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainCents) Take(n int) *chainCents {
+	return &chainCents{value: TakeCents(c.value, n), err: c.err}
+}
+
+func TakeRightCents(slice []Cents, n int) (res []Cents) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]Cents, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainCents) TakeRight(n int) *chainCents {
+	return &chainCents{value: TakeRightCents(c.value, n), err: c.err}
+}
+
+func TakeWhileCents(slice []Cents, fn func(Cents, int) bool) (res []Cents) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]Cents, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainCents) TakeWhile(fn func(Cents, int) bool) *chainCents {
+	return &chainCents{value: TakeWhileCents(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileCents(slice []Cents, fn func(Cents, int) bool) (res []Cents) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]Cents, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainCents) TakeRightWhile(fn func(Cents, int) bool) *chainCents {
+	return &chainCents{value: TakeRightWhileCents(c.value, fn), err: c.err}
+}
+
+func DropWhileCents(slice []Cents, fn func(Cents, int) bool) (res []Cents) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]Cents, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainCents) DropWhile(fn func(Cents, int) bool) *chainCents {
+	return &chainCents{value: DropWhileCents(c.value, fn), err: c.err}
+}
+
+func DropRightWhileCents(slice []Cents, fn func(Cents, int) bool) (res []Cents) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]Cents, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainCents) DropRightWhile(fn func(Cents, int) bool) *chainCents {
+	return &chainCents{value: DropRightWhileCents(c.value, fn), err: c.err}
+}
+
+func SliceCents(slice []Cents, start int, end int) (res []Cents) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]Cents, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainCents) Slice(start int, end int) *chainCents {
+	return &chainCents{value: SliceCents(c.value, start, end), err: c.err}
+}
+
+type chainCentsSlices struct {
+	value [][]Cents
+	err   error
+}
+
+func NewCentsSlices(slices [][]Cents) *chainCentsSlices {
+	return &chainCentsSlices{value: slices}
+}
+
+func (c *chainCentsSlices) Value() [][]Cents {
+	return c.value
+}
+
+func (c *chainCentsSlices) Result() ([][]Cents, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainCentsSlices) Each(fn func([]Cents, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainCentsSlices) Map(fn func([]Cents, int) []Cents) *chainCentsSlices {
+	res := make([][]Cents, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainCentsSlices{value: res, err: c.err}
+}
+
+func (c *chainCentsSlices) Reduce(fn func(Cents, Cents, int) Cents, initial Cents) *chainCents {
+	res := make([]Cents, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainCents{value: res, err: c.err}
+}
+
+func ChunkCents(slice []Cents, size int) (res [][]Cents) {
+	res = [][]Cents{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]Cents, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainCents) Chunk(size int) *chainCentsSlices {
+	return &chainCentsSlices{value: ChunkCents(c.value, size), err: c.err}
+}
+
+func WindowCents(slice []Cents, size int, step int) (res [][]Cents) {
+	res = [][]Cents{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]Cents, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainCents) Window(size int, step int) *chainCentsSlices {
+	return &chainCentsSlices{value: WindowCents(c.value, size, step), err: c.err}
+}
+
+func FlattenCents(slices [][]Cents) (res []Cents) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]Cents, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainCentsSlices) Flatten() *chainCents {
+	return &chainCents{value: FlattenCents(c.value), err: c.err}
+}
+
+func FlatMapCents(slice []Cents, fn func(Cents, int) []Cents) (res []Cents) {
+	mapped := make([][]Cents, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]Cents, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainCents) FlatMap(fn func(Cents, int) []Cents) *chainCents {
+	return &chainCents{value: FlatMapCents(c.value, fn), err: c.err}
+}
+
+func SumCents(slice []Cents) (res Cents) {
+	for _, entry := range slice {
+		res += entry
+	}
+	return
+}
+
+func (c *chainCents) Sum() Cents {
+	return SumCents(c.value)
+}
+
+func SumCheckedCents(slice []Cents) (res Cents, ok bool) {
+	for _, entry := range slice {
+		sum := res + entry
+		if (entry > 0 && sum < res) || (entry < 0 && sum > res) {
+			return 0, false
+		}
+		res = sum
+	}
+	return res, true
+}
+
+func (c *chainCents) SumChecked() (Cents, bool) {
+	return SumCheckedCents(c.value)
+}
+
+func ProductCents(slice []Cents) (res Cents) {
+	res = 1
+	for _, entry := range slice {
+		res *= entry
+	}
+	return
+}
+
+func (c *chainCents) Product() Cents {
+	return ProductCents(c.value)
+}
+
+func MinCents(slice []Cents) (res Cents, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if entry < res {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainCents) Min() (Cents, bool) {
+	return MinCents(c.value)
+}
+
+func MaxCents(slice []Cents) (res Cents, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if res < entry {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainCents) Max() (Cents, bool) {
+	return MaxCents(c.value)
+}
+
+func MinByCents(slice []Cents, less func(a, b Cents) bool) (res Cents, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainCents) MinBy(less func(a, b Cents) bool) (Cents, bool) {
+	return MinByCents(c.value, less)
+}
+
+func MaxByCents(slice []Cents, less func(a, b Cents) bool) (res Cents, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainCents) MaxBy(less func(a, b Cents) bool) (Cents, bool) {
+	return MaxByCents(c.value, less)
+}
+
+func MeanCents(slice []Cents) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	for _, entry := range slice {
+		res += float64(entry)
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainCents) Mean() (float64, bool) {
+	return MeanCents(c.value)
+}
+
+func MedianCents(slice []Cents) (float64, bool) {
+	return PercentileCents(slice, 50)
+}
+
+func (c *chainCents) Median() (float64, bool) {
+	return MedianCents(c.value)
+}
+
+func PercentileCents(slice []Cents, p float64) (res float64, ok bool) {
+	if len(slice) == 0 || !(p >= 0 && p <= 100) {
+		return
+	}
+
+	sorted := make([]float64, len(slice))
+	for index, entry := range slice {
+		sorted[index] = float64(entry)
+	}
+	sort.Float64s(sorted)
+
+	// interpolate between the closest ranks
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower == len(sorted)-1 {
+		return sorted[lower], true
+	}
+	return sorted[lower] + (sorted[lower+1]-sorted[lower])*(rank-float64(lower)), true
+}
+
+func (c *chainCents) Percentile(p float64) (float64, bool) {
+	return PercentileCents(c.value, p)
+}
+
+func VarianceCents(slice []Cents) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+
+	mean := 0.0
+	for _, entry := range slice {
+		mean += float64(entry)
+	}
+	mean /= float64(len(slice))
+
+	for _, entry := range slice {
+		d := float64(entry) - mean
+		res += d * d
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainCents) Variance() (float64, bool) {
+	return VarianceCents(c.value)
+}
+
+func StdDevCents(slice []Cents) (float64, bool) {
+	variance, ok := VarianceCents(slice)
+	return math.Sqrt(variance), ok
+}
+
+func (c *chainCents) StdDev() (float64, bool) {
+	return StdDevCents(c.value)
+}
+
+func ShuffleCents(slice []Cents, rnd interface{ Intn(int) int }) (res []Cents) {
+	res = make([]Cents, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainCents) Shuffle(rnd interface{ Intn(int) int }) *chainCents {
+	return &chainCents{value: ShuffleCents(c.value, rnd), err: c.err}
+}
+
+func SampleCents(slice []Cents, rnd interface{ Intn(int) int }) (res Cents, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainCents) Sample(rnd interface{ Intn(int) int }) (Cents, bool) {
+	return SampleCents(c.value, rnd)
+}
+
+func SampleNCents(slice []Cents, n int, rnd interface{ Intn(int) int }) (res []Cents) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]Cents, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainCents) SampleN(n int, rnd interface{ Intn(int) int }) *chainCents {
+	return &chainCents{value: SampleNCents(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceCents(slice []Cents, weight func(Cents) float64, rnd interface{ Float64() float64 }) (res Cents, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainCents) WeightedChoice(weight func(Cents) float64, rnd interface{ Float64() float64 }) (Cents, bool) {
+	return WeightedChoiceCents(c.value, weight, rnd)
+}
+
+// lazyCentsStep is a step of a lazyCents. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyCentsStep struct {
+	start func() func(Cents) (Cents, bool, bool)
+	apply func([]Cents) []Cents
+}
+
+type lazyCents struct {
+	isPtr  bool
+	err    error
+	source []Cents
+	steps  []lazyCentsStep
+}
+
+func (c *chainCents) Lazy() *lazyCents {
+	return &lazyCents{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyCents) then(step lazyCentsStep) *lazyCents {
+	// copied, so that chains can branch from c
+	steps := make([]lazyCentsStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyCents{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyCents) Filter(fn func(Cents, int) bool) *lazyCents {
+	return c.then(lazyCentsStep{start: func() func(Cents) (Cents, bool, bool) {
+		index := 0
+		return func(entry Cents) (Cents, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyCents) Map(fn func(Cents, int) Cents) *lazyCents {
+	return c.then(lazyCentsStep{start: func() func(Cents) (Cents, bool, bool) {
+		index := 0
+		return func(entry Cents) (Cents, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyCents) Drop(n int) *lazyCents {
+	return c.then(lazyCentsStep{start: func() func(Cents) (Cents, bool, bool) {
+		seen := 0
+		return func(entry Cents) (Cents, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyCents) Take(n int) *lazyCents {
+	return c.then(lazyCentsStep{start: func() func(Cents) (Cents, bool, bool) {
+		taken := 0
+		return func(entry Cents) (Cents, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyCents) TakeWhile(fn func(Cents, int) bool) *lazyCents {
+	return c.then(lazyCentsStep{start: func() func(Cents) (Cents, bool, bool) {
+		index := 0
+		return func(entry Cents) (Cents, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyCents) DropWhile(fn func(Cents, int) bool) *lazyCents {
+	return c.then(lazyCentsStep{start: func() func(Cents) (Cents, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry Cents) (Cents, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyCents) Reverse() *lazyCents {
+	return c.then(lazyCentsStep{apply: func(slice []Cents) []Cents {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyCents) DropRight(n int) *lazyCents {
+	return c.then(lazyCentsStep{apply: func(slice []Cents) []Cents {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyCents) TakeRight(n int) *lazyCents {
+	return c.then(lazyCentsStep{apply: func(slice []Cents) []Cents {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyCents) Value() []Cents {
+	res := make([]Cents, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(Cents) (Cents, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyCents) Eager() *chainCents {
+	return &chainCents{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelCents calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelCents(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachCents(slice []Cents, fn func(Cents, int), workers int) {
+	runParallelCents(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelCents struct {
+	isPtr   bool
+	err     error
+	value   []Cents
+	workers int
+}
+
+func (c *chainCents) Parallel(workers int) *parallelCents {
+	return &parallelCents{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelCents) ForEach(fn func(Cents, int)) {
+	ParallelForEachCents(c.value, fn, c.workers)
+}
+
+func (c *parallelCents) Value() []Cents {
+	return c.value
+}
+
+func (c *parallelCents) Result() ([]Cents, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelCents) Sequential() *chainCents {
+	return &chainCents{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapCents(slice []Cents, fn func(Cents, int) Cents, workers int) (res []Cents) {
+	res = make([]Cents, len(slice))
+	runParallelCents(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelCents) Map(fn func(Cents, int) Cents) *parallelCents {
+	return &parallelCents{value: ParallelMapCents(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterCents(slice []Cents, fn func(Cents, int) bool, workers int) (res []Cents) {
+	keep := make([]bool, len(slice))
+	runParallelCents(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]Cents, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelCents) Filter(fn func(Cents, int) bool) *parallelCents {
+	return &parallelCents{value: ParallelFilterCents(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrCents(slice []Cents, fn func(Cents, int) (Cents, error)) (res []Cents, err error) {
+	res = make([]Cents, 0, len(slice))
+	for index, entry := range slice {
+		var val Cents
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainCents) MapErr(fn func(Cents, int) (Cents, error)) *chainCents {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrCents(c.value, fn)
+	return &chainCents{value: res, err: err}
+}
+
+func FilterErrCents(slice []Cents, fn func(Cents, int) (bool, error)) (res []Cents, err error) {
+	res = make([]Cents, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainCents) FilterErr(fn func(Cents, int) (bool, error)) *chainCents {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrCents(c.value, fn)
+	return &chainCents{value: res, err: err}
+}
+
+func ReduceErrCents(slice []Cents, fn func(Cents, Cents, int) (Cents, error), initial Cents) (res Cents, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainCents) ReduceErr(fn func(Cents, Cents, int) (Cents, error), initial Cents) *chainCents {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrCents(c.value, fn, initial)
+	if err != nil {
+		return &chainCents{err: err}
+	}
+	return &chainCents{value: []Cents{res}}
+}
+
+func ForEachErrCents(slice []Cents, fn func(Cents, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainCents) ForEachErr(fn func(Cents, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrCents(c.value, fn)
+}
+
+func MapCtxCents(ctx context.Context, slice []Cents, fn func(Cents, int) (Cents, error)) ([]Cents, error) {
+	return MapErrCents(slice, func(entry Cents, index int) (Cents, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainCents) MapCtx(ctx context.Context, fn func(Cents, int) (Cents, error)) *chainCents {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxCents(ctx, c.value, fn)
+	return &chainCents{value: res, err: err}
+}
+
+func FilterCtxCents(ctx context.Context, slice []Cents, fn func(Cents, int) (bool, error)) ([]Cents, error) {
+	return FilterErrCents(slice, func(entry Cents, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainCents) FilterCtx(ctx context.Context, fn func(Cents, int) (bool, error)) *chainCents {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxCents(ctx, c.value, fn)
+	return &chainCents{value: res, err: err}
+}
+
+func ReduceCtxCents(ctx context.Context, slice []Cents, fn func(Cents, Cents, int) (Cents, error), initial Cents) (Cents, error) {
+	return ReduceErrCents(slice, func(acc Cents, entry Cents, index int) (Cents, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainCents) ReduceCtx(ctx context.Context, fn func(Cents, Cents, int) (Cents, error), initial Cents) *chainCents {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxCents(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainCents{err: err}
+	}
+	return &chainCents{value: []Cents{res}}
+}
+
+func ForEachCtxCents(ctx context.Context, slice []Cents, fn func(Cents, int) error) error {
+	return ForEachErrCents(slice, func(entry Cents, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainCents) ForEachCtx(ctx context.Context, fn func(Cents, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxCents(ctx, c.value, fn)
+}
+
+func ParallelMapCtxCents(ctx context.Context, slice []Cents, fn func(Cents, int) (Cents, error), workers int) ([]Cents, error) {
+	res := make([]Cents, len(slice))
+	err := runParallelCents(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelCents) MapCtx(ctx context.Context, fn func(Cents, int) (Cents, error)) *parallelCents {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxCents(ctx, c.value, fn, c.workers)
+	return &parallelCents{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxCents(ctx context.Context, slice []Cents, fn func(Cents, int) (bool, error), workers int) ([]Cents, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelCents(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Cents, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelCents) FilterCtx(ctx context.Context, fn func(Cents, int) (bool, error)) *parallelCents {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxCents(ctx, c.value, fn, c.workers)
+	return &parallelCents{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxCents(ctx context.Context, slice []Cents, fn func(Cents, int) error, workers int) error {
+	return runParallelCents(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelCents) ForEachCtx(ctx context.Context, fn func(Cents, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxCents(ctx, c.value, fn, c.workers)
+}
+
+type chainUint8 struct {
+	isPtr bool
+	value []uint8
+	err   error
+}
+
+func NewUint8Slice(slice []uint8) *chainUint8 {
+	return &chainUint8{
+		value: slice,
+	}
+}
+
+func (c *chainUint8) Value() []uint8 {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainUint8) Result() ([]uint8, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func ConcatUint8(slice []uint8, slice2 []uint8) (res []uint8) {
+	res = make([]uint8, 0, len(slice)+len(slice2))
+	for _, entry := range slice {
+		res = append(res, entry)
+	}
+	for _, entry := range slice2 {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainUint8) Concat(slice2 []uint8) *chainUint8 {
+	return &chainUint8{value: ConcatUint8(c.value, slice2), err: c.err}
+}
+
+func ContainsUint8(slice []uint8, item uint8) (res bool) {
+	for _, val := range slice {
+		if val == item {
+			return true
+		}
+
+	}
+	return false
+}
+
+func (c *chainUint8) Contains(item uint8) bool {
+	return ContainsUint8(c.value, item)
+}
+
+func DropUint8(slice []uint8, n int) (res []uint8) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]uint8, 0, l)
+	for _, entry := range slice[len(slice)-l:] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainUint8) Drop(n int) *chainUint8 {
+	return &chainUint8{value: DropUint8(c.value, n), err: c.err}
+}
+
+func DropRightUint8(slice []uint8, n int) (res []uint8) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]uint8, 0, l)
+	for _, entry := range slice[:l] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainUint8) DropRight(n int) *chainUint8 {
+	return &chainUint8{value: DropRightUint8(c.value, n), err: c.err}
+}
+
+func FilterUint8(slice []uint8, fn func(uint8, int) bool) (res []uint8) {
+	res = make([]uint8, 0, len(slice))
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainUint8) Filter(fn func(uint8, int) bool) *chainUint8 {
+	return &chainUint8{value: FilterUint8(c.value, fn), err: c.err}
+}
+
+func FirstUint8(slice []uint8) (res uint8) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	return
+}
+
+func (c *chainUint8) First() *chainUint8 {
+	return &chainUint8{value: []uint8{FirstUint8(c.value)}, err: c.err}
+}
+
+func LastUint8(slice []uint8) (res uint8) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[len(slice)-1]
+	return
+}
+
+func (c *chainUint8) Last() *chainUint8 {
+	return &chainUint8{value: []uint8{LastUint8(c.value)}, err: c.err}
+}
+
+func MapUint8(slice []uint8, fn func(uint8, int) uint8) (res []uint8) {
+	res = make([]uint8, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainUint8) Map(fn func(uint8, int) uint8) *chainUint8 {
+	return &chainUint8{value: MapUint8(c.value, fn), err: c.err}
+}
+
+func ReduceUint8(slice []uint8, fn func(uint8, uint8, int) uint8, initial uint8) (res uint8) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainUint8) Reduce(fn func(uint8, uint8, int) uint8, initial uint8) *chainUint8 {
+	return &chainUint8{value: []uint8{ReduceUint8(c.value, fn, initial)}, err: c.err}
+}
+
+func ReverseUint8(slice []uint8) (res []uint8) {
+	res = make([]uint8, len(slice))
+	for index, entry := range slice {
+		res[len(slice)-1-index] = entry
+	}
+	return
+}
+
+func (c *chainUint8) Reverse() *chainUint8 {
+	return &chainUint8{value: ReverseUint8(c.value), err: c.err}
+}
+
+func UniqUint8(slice []uint8) (res []uint8) {
+	seen := make(map[uint8]bool)
+	res = []uint8{}
+	for _, entry := range slice {
+		if _, found := seen[entry]; !found {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+
+}
+
+func (c *chainUint8) Uniq() *chainUint8 {
+	if c.isPtr {
+		panic("Uniq() does not currently support pointers")
+	}
+	return &chainUint8{value: UniqUint8(c.value), err: c.err}
+}
+
+func SortUint8(slice []uint8) (res []uint8) {
+	res = make([]uint8, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainUint8) Sort() *chainUint8 {
+	return &chainUint8{value: SortUint8(c.value), err: c.err}
+}
+
+func SortStableUint8(slice []uint8) (res []uint8) {
+	res = make([]uint8, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainUint8) SortStable() *chainUint8 {
+	return &chainUint8{value: SortStableUint8(c.value), err: c.err}
+}
+
+func IsSortedUint8(slice []uint8) bool {
+	for index := 1; index < len(slice); index++ {
+		if slice[index] < slice[index-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainUint8) IsSorted() bool {
+	return IsSortedUint8(c.value)
+}
+
+func SortByUint8(slice []uint8, less func(a, b uint8) bool) (res []uint8) {
+	res = make([]uint8, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainUint8) SortBy(less func(a, b uint8) bool) *chainUint8 {
+	return &chainUint8{value: SortByUint8(c.value, less), err: c.err}
+}
+
+func SortStableByUint8(slice []uint8, less func(a, b uint8) bool) (res []uint8) {
+	res = make([]uint8, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainUint8) SortStableBy(less func(a, b uint8) bool) *chainUint8 {
+	return &chainUint8{value: SortStableByUint8(c.value, less), err: c.err}
+}
+
+func IsSortedByUint8(slice []uint8, less func(a, b uint8) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainUint8) IsSortedBy(less func(a, b uint8) bool) bool {
+	return IsSortedByUint8(c.value, less)
+}
+
+func UnionUint8(slice []uint8, slice2 []uint8) (res []uint8) {
+	res = []uint8{}
+	seen := make(map[uint8]bool)
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainUint8) Union(slice2 []uint8) *chainUint8 {
+	return &chainUint8{value: UnionUint8(c.value, slice2), err: c.err}
+}
+
+func IntersectionUint8(slice []uint8, slice2 []uint8) (res []uint8) {
+	res = []uint8{}
+	in := make(map[uint8]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	seen := make(map[uint8]bool)
+	for _, entry := range slice {
+		if in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainUint8) Intersection(slice2 []uint8) *chainUint8 {
+	return &chainUint8{value: IntersectionUint8(c.value, slice2), err: c.err}
+}
+
+func DifferenceUint8(slice []uint8, slice2 []uint8) (res []uint8) {
+	res = []uint8{}
+	seen := make(map[uint8]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainUint8) Difference(slice2 []uint8) *chainUint8 {
+	return &chainUint8{value: DifferenceUint8(c.value, slice2), err: c.err}
+}
+
+func XorUint8(slice []uint8, slice2 []uint8) (res []uint8) {
+	res = []uint8{}
+	in := make(map[uint8]bool, len(slice))
+	for _, entry := range slice {
+		in[entry] = true
+	}
+	in2 := make(map[uint8]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[entry] = true
+	}
+	seen := make(map[uint8]bool)
+	for _, entry := range slice {
+		if !in2[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainUint8) Xor(slice2 []uint8) *chainUint8 {
+	return &chainUint8{value: XorUint8(c.value, slice2), err: c.err}
+}
+
+func IsSubsetUint8(slice []uint8, slice2 []uint8) bool {
+	in := make(map[uint8]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if !in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainUint8) IsSubset(slice2 []uint8) bool {
+	return IsSubsetUint8(c.value, slice2)
+}
+
+func IsDisjointUint8(slice []uint8, slice2 []uint8) bool {
+	in := make(map[uint8]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainUint8) IsDisjoint(slice2 []uint8) bool {
+	return IsDisjointUint8(c.value, slice2)
+}
+
+func PartitionUint8(slice []uint8, fn func(uint8, int) bool) (yes []uint8, no []uint8) {
+	yes = []uint8{}
+	no = []uint8{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainUint8) Partition(fn func(uint8, int) bool) ([]uint8, []uint8) {
+	return PartitionUint8(c.value, fn)
+}
+
+func FindUint8(slice []uint8, fn func(uint8, int) bool) (res uint8, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainUint8) Find(fn func(uint8, int) bool) (uint8, bool) {
+	return FindUint8(c.value, fn)
+}
+
+func FindIndexUint8(slice []uint8, fn func(uint8, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainUint8) FindIndex(fn func(uint8, int) bool) int {
+	return FindIndexUint8(c.value, fn)
+}
+
+func FindLastUint8(slice []uint8, fn func(uint8, int) bool) (res uint8, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainUint8) FindLast(fn func(uint8, int) bool) (uint8, bool) {
+	return FindLastUint8(c.value, fn)
+}
+
+func FindLastIndexUint8(slice []uint8, fn func(uint8, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainUint8) FindLastIndex(fn func(uint8, int) bool) int {
+	return FindLastIndexUint8(c.value, fn)
+}
+
+func IndexOfUint8(slice []uint8, item uint8) int {
+	for index, val := range slice {
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainUint8) IndexOf(item uint8) int {
+	return IndexOfUint8(c.value, item)
+}
+
+func LastIndexOfUint8(slice []uint8, item uint8) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainUint8) LastIndexOf(item uint8) int {
+	return LastIndexOfUint8(c.value, item)
+}
+
+func EveryUint8(slice []uint8, fn func(uint8, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainUint8) Every(fn func(uint8, int) bool) bool {
+	return EveryUint8(c.value, fn)
+}
+
+func SomeUint8(slice []uint8, fn func(uint8, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainUint8) Some(fn func(uint8, int) bool) bool {
+	return SomeUint8(c.value, fn)
+}
+
+func NoneUint8(slice []uint8, fn func(uint8, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainUint8) None(fn func(uint8, int) bool) bool {
+	return NoneUint8(c.value, fn)
+}
+
+func CountUint8(slice []uint8, fn func(uint8, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainUint8) Count(fn func(uint8, int) bool) int {
+	return CountUint8(c.value, fn)
+}
+
+func TakeUint8(slice []uint8, n int) (res []uint8) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]uint8, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainUint8) Take(n int) *chainUint8 {
+	return &chainUint8{value: TakeUint8(c.value, n), err: c.err}
+}
+
+func TakeRightUint8(slice []uint8, n int) (res []uint8) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]uint8, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainUint8) TakeRight(n int) *chainUint8 {
+	return &chainUint8{value: TakeRightUint8(c.value, n), err: c.err}
+}
+
+func TakeWhileUint8(slice []uint8, fn func(uint8, int) bool) (res []uint8) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]uint8, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainUint8) TakeWhile(fn func(uint8, int) bool) *chainUint8 {
+	return &chainUint8{value: TakeWhileUint8(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileUint8(slice []uint8, fn func(uint8, int) bool) (res []uint8) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]uint8, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainUint8) TakeRightWhile(fn func(uint8, int) bool) *chainUint8 {
+	return &chainUint8{value: TakeRightWhileUint8(c.value, fn), err: c.err}
+}
+
+func DropWhileUint8(slice []uint8, fn func(uint8, int) bool) (res []uint8) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]uint8, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainUint8) DropWhile(fn func(uint8, int) bool) *chainUint8 {
+	return &chainUint8{value: DropWhileUint8(c.value, fn), err: c.err}
+}
+
+func DropRightWhileUint8(slice []uint8, fn func(uint8, int) bool) (res []uint8) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]uint8, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainUint8) DropRightWhile(fn func(uint8, int) bool) *chainUint8 {
+	return &chainUint8{value: DropRightWhileUint8(c.value, fn), err: c.err}
+}
+
+func SliceUint8(slice []uint8, start int, end int) (res []uint8) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]uint8, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainUint8) Slice(start int, end int) *chainUint8 {
+	return &chainUint8{value: SliceUint8(c.value, start, end), err: c.err}
+}
+
+type chainUint8Slices struct {
+	value [][]uint8
+	err   error
+}
+
+func NewUint8Slices(slices [][]uint8) *chainUint8Slices {
+	return &chainUint8Slices{value: slices}
+}
+
+func (c *chainUint8Slices) Value() [][]uint8 {
+	return c.value
+}
+
+func (c *chainUint8Slices) Result() ([][]uint8, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainUint8Slices) Each(fn func([]uint8, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainUint8Slices) Map(fn func([]uint8, int) []uint8) *chainUint8Slices {
+	res := make([][]uint8, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainUint8Slices{value: res, err: c.err}
+}
+
+func (c *chainUint8Slices) Reduce(fn func(uint8, uint8, int) uint8, initial uint8) *chainUint8 {
+	res := make([]uint8, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainUint8{value: res, err: c.err}
+}
+
+func ChunkUint8(slice []uint8, size int) (res [][]uint8) {
+	res = [][]uint8{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]uint8, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainUint8) Chunk(size int) *chainUint8Slices {
+	return &chainUint8Slices{value: ChunkUint8(c.value, size), err: c.err}
+}
+
+func WindowUint8(slice []uint8, size int, step int) (res [][]uint8) {
+	res = [][]uint8{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]uint8, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainUint8) Window(size int, step int) *chainUint8Slices {
+	return &chainUint8Slices{value: WindowUint8(c.value, size, step), err: c.err}
+}
+
+func FlattenUint8(slices [][]uint8) (res []uint8) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]uint8, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainUint8Slices) Flatten() *chainUint8 {
+	return &chainUint8{value: FlattenUint8(c.value), err: c.err}
+}
+
+func FlatMapUint8(slice []uint8, fn func(uint8, int) []uint8) (res []uint8) {
+	mapped := make([][]uint8, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]uint8, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainUint8) FlatMap(fn func(uint8, int) []uint8) *chainUint8 {
+	return &chainUint8{value: FlatMapUint8(c.value, fn), err: c.err}
+}
+
+func SumUint8(slice []uint8) (res uint8) {
+	for _, entry := range slice {
+		res += entry
+	}
+	return
+}
+
+func (c *chainUint8) Sum() uint8 {
+	return SumUint8(c.value)
+}
+
+func SumCheckedUint8(slice []uint8) (res uint8, ok bool) {
+	for _, entry := range slice {
+		sum := res + entry
+		if sum < res {
+			return 0, false
+		}
+		res = sum
+	}
+	return res, true
+}
+
+func (c *chainUint8) SumChecked() (uint8, bool) {
+	return SumCheckedUint8(c.value)
+}
+
+func ProductUint8(slice []uint8) (res uint8) {
+	res = 1
+	for _, entry := range slice {
+		res *= entry
+	}
+	return
+}
+
+func (c *chainUint8) Product() uint8 {
+	return ProductUint8(c.value)
+}
+
+func MinUint8(slice []uint8) (res uint8, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if entry < res {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainUint8) Min() (uint8, bool) {
+	return MinUint8(c.value)
+}
+
+func MaxUint8(slice []uint8) (res uint8, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if res < entry {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainUint8) Max() (uint8, bool) {
+	return MaxUint8(c.value)
+}
+
+func MinByUint8(slice []uint8, less func(a, b uint8) bool) (res uint8, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainUint8) MinBy(less func(a, b uint8) bool) (uint8, bool) {
+	return MinByUint8(c.value, less)
+}
+
+func MaxByUint8(slice []uint8, less func(a, b uint8) bool) (res uint8, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainUint8) MaxBy(less func(a, b uint8) bool) (uint8, bool) {
+	return MaxByUint8(c.value, less)
+}
+
+func MeanUint8(slice []uint8) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	for _, entry := range slice {
+		res += float64(entry)
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainUint8) Mean() (float64, bool) {
+	return MeanUint8(c.value)
+}
+
+func MedianUint8(slice []uint8) (float64, bool) {
+	return PercentileUint8(slice, 50)
+}
+
+func (c *chainUint8) Median() (float64, bool) {
+	return MedianUint8(c.value)
+}
+
+func PercentileUint8(slice []uint8, p float64) (res float64, ok bool) {
+	if len(slice) == 0 || !(p >= 0 && p <= 100) {
+		return
+	}
+
+	sorted := make([]float64, len(slice))
+	for index, entry := range slice {
+		sorted[index] = float64(entry)
+	}
+	sort.Float64s(sorted)
+
+	// interpolate between the closest ranks
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower == len(sorted)-1 {
+		return sorted[lower], true
+	}
+	return sorted[lower] + (sorted[lower+1]-sorted[lower])*(rank-float64(lower)), true
+}
+
+func (c *chainUint8) Percentile(p float64) (float64, bool) {
+	return PercentileUint8(c.value, p)
+}
+
+func VarianceUint8(slice []uint8) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+
+	mean := 0.0
+	for _, entry := range slice {
+		mean += float64(entry)
+	}
+	mean /= float64(len(slice))
+
+	for _, entry := range slice {
+		d := float64(entry) - mean
+		res += d * d
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chainUint8) Variance() (float64, bool) {
+	return VarianceUint8(c.value)
+}
+
+func StdDevUint8(slice []uint8) (float64, bool) {
+	variance, ok := VarianceUint8(slice)
+	return math.Sqrt(variance), ok
+}
+
+func (c *chainUint8) StdDev() (float64, bool) {
+	return StdDevUint8(c.value)
+}
+
+func ShuffleUint8(slice []uint8, rnd interface{ Intn(int) int }) (res []uint8) {
+	res = make([]uint8, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainUint8) Shuffle(rnd interface{ Intn(int) int }) *chainUint8 {
+	return &chainUint8{value: ShuffleUint8(c.value, rnd), err: c.err}
+}
+
+func SampleUint8(slice []uint8, rnd interface{ Intn(int) int }) (res uint8, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainUint8) Sample(rnd interface{ Intn(int) int }) (uint8, bool) {
+	return SampleUint8(c.value, rnd)
+}
+
+func SampleNUint8(slice []uint8, n int, rnd interface{ Intn(int) int }) (res []uint8) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]uint8, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainUint8) SampleN(n int, rnd interface{ Intn(int) int }) *chainUint8 {
+	return &chainUint8{value: SampleNUint8(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceUint8(slice []uint8, weight func(uint8) float64, rnd interface{ Float64() float64 }) (res uint8, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainUint8) WeightedChoice(weight func(uint8) float64, rnd interface{ Float64() float64 }) (uint8, bool) {
+	return WeightedChoiceUint8(c.value, weight, rnd)
+}
+
+// lazyUint8Step is a step of a lazyUint8. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyUint8Step struct {
+	start func() func(uint8) (uint8, bool, bool)
+	apply func([]uint8) []uint8
+}
+
+type lazyUint8 struct {
+	isPtr  bool
+	err    error
+	source []uint8
+	steps  []lazyUint8Step
+}
+
+func (c *chainUint8) Lazy() *lazyUint8 {
+	return &lazyUint8{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyUint8) then(step lazyUint8Step) *lazyUint8 {
+	// copied, so that chains can branch from c
+	steps := make([]lazyUint8Step, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyUint8{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyUint8) Filter(fn func(uint8, int) bool) *lazyUint8 {
+	return c.then(lazyUint8Step{start: func() func(uint8) (uint8, bool, bool) {
+		index := 0
+		return func(entry uint8) (uint8, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyUint8) Map(fn func(uint8, int) uint8) *lazyUint8 {
+	return c.then(lazyUint8Step{start: func() func(uint8) (uint8, bool, bool) {
+		index := 0
+		return func(entry uint8) (uint8, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyUint8) Drop(n int) *lazyUint8 {
+	return c.then(lazyUint8Step{start: func() func(uint8) (uint8, bool, bool) {
+		seen := 0
+		return func(entry uint8) (uint8, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyUint8) Take(n int) *lazyUint8 {
+	return c.then(lazyUint8Step{start: func() func(uint8) (uint8, bool, bool) {
+		taken := 0
+		return func(entry uint8) (uint8, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyUint8) TakeWhile(fn func(uint8, int) bool) *lazyUint8 {
+	return c.then(lazyUint8Step{start: func() func(uint8) (uint8, bool, bool) {
+		index := 0
+		return func(entry uint8) (uint8, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyUint8) DropWhile(fn func(uint8, int) bool) *lazyUint8 {
+	return c.then(lazyUint8Step{start: func() func(uint8) (uint8, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry uint8) (uint8, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyUint8) Reverse() *lazyUint8 {
+	return c.then(lazyUint8Step{apply: func(slice []uint8) []uint8 {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyUint8) DropRight(n int) *lazyUint8 {
+	return c.then(lazyUint8Step{apply: func(slice []uint8) []uint8 {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyUint8) TakeRight(n int) *lazyUint8 {
+	return c.then(lazyUint8Step{apply: func(slice []uint8) []uint8 {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyUint8) Value() []uint8 {
+	res := make([]uint8, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(uint8) (uint8, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyUint8) Eager() *chainUint8 {
+	return &chainUint8{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelUint8 calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelUint8(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachUint8(slice []uint8, fn func(uint8, int), workers int) {
+	runParallelUint8(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelUint8 struct {
+	isPtr   bool
+	err     error
+	value   []uint8
+	workers int
+}
+
+func (c *chainUint8) Parallel(workers int) *parallelUint8 {
+	return &parallelUint8{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelUint8) ForEach(fn func(uint8, int)) {
+	ParallelForEachUint8(c.value, fn, c.workers)
+}
+
+func (c *parallelUint8) Value() []uint8 {
+	return c.value
+}
+
+func (c *parallelUint8) Result() ([]uint8, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelUint8) Sequential() *chainUint8 {
+	return &chainUint8{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapUint8(slice []uint8, fn func(uint8, int) uint8, workers int) (res []uint8) {
+	res = make([]uint8, len(slice))
+	runParallelUint8(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelUint8) Map(fn func(uint8, int) uint8) *parallelUint8 {
+	return &parallelUint8{value: ParallelMapUint8(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterUint8(slice []uint8, fn func(uint8, int) bool, workers int) (res []uint8) {
+	keep := make([]bool, len(slice))
+	runParallelUint8(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]uint8, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelUint8) Filter(fn func(uint8, int) bool) *parallelUint8 {
+	return &parallelUint8{value: ParallelFilterUint8(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrUint8(slice []uint8, fn func(uint8, int) (uint8, error)) (res []uint8, err error) {
+	res = make([]uint8, 0, len(slice))
+	for index, entry := range slice {
+		var val uint8
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainUint8) MapErr(fn func(uint8, int) (uint8, error)) *chainUint8 {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrUint8(c.value, fn)
+	return &chainUint8{value: res, err: err}
+}
+
+func FilterErrUint8(slice []uint8, fn func(uint8, int) (bool, error)) (res []uint8, err error) {
+	res = make([]uint8, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainUint8) FilterErr(fn func(uint8, int) (bool, error)) *chainUint8 {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrUint8(c.value, fn)
+	return &chainUint8{value: res, err: err}
+}
+
+func ReduceErrUint8(slice []uint8, fn func(uint8, uint8, int) (uint8, error), initial uint8) (res uint8, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainUint8) ReduceErr(fn func(uint8, uint8, int) (uint8, error), initial uint8) *chainUint8 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrUint8(c.value, fn, initial)
+	if err != nil {
+		return &chainUint8{err: err}
+	}
+	return &chainUint8{value: []uint8{res}}
+}
+
+func ForEachErrUint8(slice []uint8, fn func(uint8, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainUint8) ForEachErr(fn func(uint8, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrUint8(c.value, fn)
+}
+
+func MapCtxUint8(ctx context.Context, slice []uint8, fn func(uint8, int) (uint8, error)) ([]uint8, error) {
+	return MapErrUint8(slice, func(entry uint8, index int) (uint8, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainUint8) MapCtx(ctx context.Context, fn func(uint8, int) (uint8, error)) *chainUint8 {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxUint8(ctx, c.value, fn)
+	return &chainUint8{value: res, err: err}
+}
+
+func FilterCtxUint8(ctx context.Context, slice []uint8, fn func(uint8, int) (bool, error)) ([]uint8, error) {
+	return FilterErrUint8(slice, func(entry uint8, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainUint8) FilterCtx(ctx context.Context, fn func(uint8, int) (bool, error)) *chainUint8 {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxUint8(ctx, c.value, fn)
+	return &chainUint8{value: res, err: err}
+}
+
+func ReduceCtxUint8(ctx context.Context, slice []uint8, fn func(uint8, uint8, int) (uint8, error), initial uint8) (uint8, error) {
+	return ReduceErrUint8(slice, func(acc uint8, entry uint8, index int) (uint8, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainUint8) ReduceCtx(ctx context.Context, fn func(uint8, uint8, int) (uint8, error), initial uint8) *chainUint8 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxUint8(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainUint8{err: err}
+	}
+	return &chainUint8{value: []uint8{res}}
+}
+
+func ForEachCtxUint8(ctx context.Context, slice []uint8, fn func(uint8, int) error) error {
+	return ForEachErrUint8(slice, func(entry uint8, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainUint8) ForEachCtx(ctx context.Context, fn func(uint8, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxUint8(ctx, c.value, fn)
+}
+
+func ParallelMapCtxUint8(ctx context.Context, slice []uint8, fn func(uint8, int) (uint8, error), workers int) ([]uint8, error) {
+	res := make([]uint8, len(slice))
+	err := runParallelUint8(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelUint8) MapCtx(ctx context.Context, fn func(uint8, int) (uint8, error)) *parallelUint8 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxUint8(ctx, c.value, fn, c.workers)
+	return &parallelUint8{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxUint8(ctx context.Context, slice []uint8, fn func(uint8, int) (bool, error), workers int) ([]uint8, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelUint8(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]uint8, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelUint8) FilterCtx(ctx context.Context, fn func(uint8, int) (bool, error)) *parallelUint8 {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxUint8(ctx, c.value, fn, c.workers)
+	return &parallelUint8{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxUint8(ctx context.Context, slice []uint8, fn func(uint8, int) error, workers int) error {
+	return runParallelUint8(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelUint8) ForEachCtx(ctx context.Context, fn func(uint8, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxUint8(ctx, c.value, fn, c.workers)
+}
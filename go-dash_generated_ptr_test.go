@@ -1,13 +1,19 @@
 // This code is generated by https://github.com/jtyers/slice
 // DO NOT EDIT!
 
-
-
 package main
 
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
 type chainStringPtr struct {
-  isPtr bool
+	isPtr bool
 	value []*string
+	err   error
 }
 
 func NewStringPtrSlice(slice []*string) *chainStringPtr {
@@ -21,8 +27,18 @@ func (c *chainStringPtr) Value() []*string {
 	return c.value
 }
 
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainStringPtr) Result() ([]*string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
 func ConcatStringPtr(slice []*string, slice2 []*string) (res []*string) {
-	res = make([]*string, 0, len(slice) + len(slice2))
+	res = make([]*string, 0, len(slice)+len(slice2))
 	for _, entry := range slice {
 		res = append(res, entry)
 	}
@@ -33,7 +49,7 @@ func ConcatStringPtr(slice []*string, slice2 []*string) (res []*string) {
 }
 
 func (c *chainStringPtr) Concat(slice2 []*string) *chainStringPtr {
-	return &chainStringPtr{value: ConcatStringPtr(c.value, slice2)}
+	return &chainStringPtr{value: ConcatStringPtr(c.value, slice2), err: c.err}
 }
 
 func ContainsStringPtr(slice []*string, item *string) (res bool) {
@@ -57,15 +73,18 @@ func DropStringPtr(slice []*string, n int) (res []*string) {
 	if l < 0 {
 		l = 0
 	}
+	if l > len(slice) {
+		l = len(slice)
+	}
 	res = make([]*string, 0, l)
-	for _, entry := range slice[len(slice) - l:] {
+	for _, entry := range slice[len(slice)-l:] {
 		res = append(res, entry)
 	}
 	return
 }
 
 func (c *chainStringPtr) Drop(n int) *chainStringPtr {
-	return &chainStringPtr{value: DropStringPtr(c.value, n)}
+	return &chainStringPtr{value: DropStringPtr(c.value, n), err: c.err}
 }
 
 func DropRightStringPtr(slice []*string, n int) (res []*string) {
@@ -73,6 +92,9 @@ func DropRightStringPtr(slice []*string, n int) (res []*string) {
 	if l < 0 {
 		l = 0
 	}
+	if l > len(slice) {
+		l = len(slice)
+	}
 	res = make([]*string, 0, l)
 	for _, entry := range slice[:l] {
 		res = append(res, entry)
@@ -81,10 +103,10 @@ func DropRightStringPtr(slice []*string, n int) (res []*string) {
 }
 
 func (c *chainStringPtr) DropRight(n int) *chainStringPtr {
-	return &chainStringPtr{value: DropRightStringPtr(c.value, n)}
+	return &chainStringPtr{value: DropRightStringPtr(c.value, n), err: c.err}
 }
 
-func FilterStringPtr(slice []*string, fn func(*string,int)bool) (res []*string) {
+func FilterStringPtr(slice []*string, fn func(*string, int) bool) (res []*string) {
 	res = make([]*string, 0, len(slice))
 	for index, entry := range slice {
 		if fn(entry, index) {
@@ -94,8 +116,8 @@ func FilterStringPtr(slice []*string, fn func(*string,int)bool) (res []*string)
 	return
 }
 
-func (c *chainStringPtr) Filter(fn func(*string,int)bool) *chainStringPtr {
-	return &chainStringPtr{value: FilterStringPtr(c.value, fn)}
+func (c *chainStringPtr) Filter(fn func(*string, int) bool) *chainStringPtr {
+	return &chainStringPtr{value: FilterStringPtr(c.value, fn), err: c.err}
 }
 
 func FirstStringPtr(slice []*string) (res *string) {
@@ -107,22 +129,22 @@ func FirstStringPtr(slice []*string) (res *string) {
 }
 
 func (c *chainStringPtr) First() *chainStringPtr {
-	return &chainStringPtr{value: []*string{FirstStringPtr(c.value)}}
+	return &chainStringPtr{value: []*string{FirstStringPtr(c.value)}, err: c.err}
 }
 
 func LastStringPtr(slice []*string) (res *string) {
 	if len(slice) == 0 {
 		return
 	}
-	res = slice[len(slice) - 1]
+	res = slice[len(slice)-1]
 	return
 }
 
 func (c *chainStringPtr) Last() *chainStringPtr {
-	return &chainStringPtr{value: []*string{LastStringPtr(c.value)}}
+	return &chainStringPtr{value: []*string{LastStringPtr(c.value)}, err: c.err}
 }
 
-func MapStringPtr(slice []*string, fn func(*string,int)*string) (res []*string) {
+func MapStringPtr(slice []*string, fn func(*string, int) *string) (res []*string) {
 	res = make([]*string, 0, len(slice))
 	for index, entry := range slice {
 		res = append(res, fn(entry, index))
@@ -130,12 +152,11 @@ func MapStringPtr(slice []*string, fn func(*string,int)*string) (res []*string)
 	return
 }
 
-func (c *chainStringPtr) Map(fn func(*string,int)*string) *chainStringPtr {
-	return &chainStringPtr{value: MapStringPtr(c.value, fn)}
+func (c *chainStringPtr) Map(fn func(*string, int) *string) *chainStringPtr {
+	return &chainStringPtr{value: MapStringPtr(c.value, fn), err: c.err}
 }
 
-
-func ReduceStringPtr(slice []*string, fn func(*string,*string,int)*string, initial *string) (res *string) {
+func ReduceStringPtr(slice []*string, fn func(*string, *string, int) *string, initial *string) (res *string) {
 	res = initial
 	for index, entry := range slice {
 		res = fn(res, entry, index)
@@ -143,8 +164,8 @@ func ReduceStringPtr(slice []*string, fn func(*string,*string,int)*string, initi
 	return
 }
 
-func (c *chainStringPtr) Reduce(fn func(*string,*string,int)*string, initial *string) *chainStringPtr {
-	return &chainStringPtr{value: []*string{ReduceStringPtr(c.value, fn, initial)}}
+func (c *chainStringPtr) Reduce(fn func(*string, *string, int) *string, initial *string) *chainStringPtr {
+	return &chainStringPtr{value: []*string{ReduceStringPtr(c.value, fn, initial)}, err: c.err}
 }
 
 func ReverseStringPtr(slice []*string) (res []*string) {
@@ -156,7 +177,7 @@ func ReverseStringPtr(slice []*string) (res []*string) {
 }
 
 func (c *chainStringPtr) Reverse() *chainStringPtr {
-	return &chainStringPtr{value: ReverseStringPtr(c.value)}
+	return &chainStringPtr{value: ReverseStringPtr(c.value), err: c.err}
 }
 
 func UniqStringPtr(slice []*string) (res []*string) {
@@ -169,11 +190,1275 @@ func UniqStringPtr(slice []*string) (res []*string) {
 		}
 	}
 	return
+
 }
 
 func (c *chainStringPtr) Uniq() *chainStringPtr {
 	if c.isPtr {
 		panic("Uniq() does not currently support pointers")
 	}
-	return &chainStringPtr{value: UniqStringPtr(c.value)}
+	return &chainStringPtr{value: UniqStringPtr(c.value), err: c.err}
+}
+
+func SortStringPtr(slice []*string) (res []*string) {
+	res = make([]*string, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return *res[i] < *res[j]
+	})
+	return
+}
+
+func (c *chainStringPtr) Sort() *chainStringPtr {
+	return &chainStringPtr{value: SortStringPtr(c.value), err: c.err}
+}
+
+func SortStableStringPtr(slice []*string) (res []*string) {
+	res = make([]*string, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return *res[i] < *res[j]
+	})
+	return
+}
+
+func (c *chainStringPtr) SortStable() *chainStringPtr {
+	return &chainStringPtr{value: SortStableStringPtr(c.value), err: c.err}
+}
+
+func IsSortedStringPtr(slice []*string) bool {
+	for index := 1; index < len(slice); index++ {
+		if *slice[index] < *slice[index-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainStringPtr) IsSorted() bool {
+	return IsSortedStringPtr(c.value)
+}
+
+func SortByStringPtr(slice []*string, less func(a, b *string) bool) (res []*string) {
+	res = make([]*string, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainStringPtr) SortBy(less func(a, b *string) bool) *chainStringPtr {
+	return &chainStringPtr{value: SortByStringPtr(c.value, less), err: c.err}
+}
+
+func SortStableByStringPtr(slice []*string, less func(a, b *string) bool) (res []*string) {
+	res = make([]*string, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainStringPtr) SortStableBy(less func(a, b *string) bool) *chainStringPtr {
+	return &chainStringPtr{value: SortStableByStringPtr(c.value, less), err: c.err}
+}
+
+func IsSortedByStringPtr(slice []*string, less func(a, b *string) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainStringPtr) IsSortedBy(less func(a, b *string) bool) bool {
+	return IsSortedByStringPtr(c.value, less)
+}
+
+func UnionStringPtr(slice []*string, slice2 []*string) (res []*string) {
+	res = []*string{}
+	seen := make(map[string]bool)
+	for _, entry := range slice {
+		if !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) Union(slice2 []*string) *chainStringPtr {
+	return &chainStringPtr{value: UnionStringPtr(c.value, slice2), err: c.err}
+}
+
+func IntersectionStringPtr(slice []*string, slice2 []*string) (res []*string) {
+	res = []*string{}
+	in := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[*entry] = true
+	}
+	seen := make(map[string]bool)
+	for _, entry := range slice {
+		if in[*entry] && !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) Intersection(slice2 []*string) *chainStringPtr {
+	return &chainStringPtr{value: IntersectionStringPtr(c.value, slice2), err: c.err}
+}
+
+func DifferenceStringPtr(slice []*string, slice2 []*string) (res []*string) {
+	res = []*string{}
+	seen := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[*entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) Difference(slice2 []*string) *chainStringPtr {
+	return &chainStringPtr{value: DifferenceStringPtr(c.value, slice2), err: c.err}
+}
+
+func XorStringPtr(slice []*string, slice2 []*string) (res []*string) {
+	res = []*string{}
+	in := make(map[string]bool, len(slice))
+	for _, entry := range slice {
+		in[*entry] = true
+	}
+	in2 := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[*entry] = true
+	}
+	seen := make(map[string]bool)
+	for _, entry := range slice {
+		if !in2[*entry] && !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[*entry] && !seen[*entry] {
+			seen[*entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) Xor(slice2 []*string) *chainStringPtr {
+	return &chainStringPtr{value: XorStringPtr(c.value, slice2), err: c.err}
+}
+
+func IsSubsetStringPtr(slice []*string, slice2 []*string) bool {
+	in := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[*entry] = true
+	}
+	for _, entry := range slice {
+		if !in[*entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainStringPtr) IsSubset(slice2 []*string) bool {
+	return IsSubsetStringPtr(c.value, slice2)
+}
+
+func IsDisjointStringPtr(slice []*string, slice2 []*string) bool {
+	in := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[*entry] = true
+	}
+	for _, entry := range slice {
+		if in[*entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainStringPtr) IsDisjoint(slice2 []*string) bool {
+	return IsDisjointStringPtr(c.value, slice2)
+}
+
+func PartitionStringPtr(slice []*string, fn func(*string, int) bool) (yes []*string, no []*string) {
+	yes = []*string{}
+	no = []*string{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) Partition(fn func(*string, int) bool) ([]*string, []*string) {
+	return PartitionStringPtr(c.value, fn)
+}
+
+func FindStringPtr(slice []*string, fn func(*string, int) bool) (res *string, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) Find(fn func(*string, int) bool) (*string, bool) {
+	return FindStringPtr(c.value, fn)
+}
+
+func FindIndexStringPtr(slice []*string, fn func(*string, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainStringPtr) FindIndex(fn func(*string, int) bool) int {
+	return FindIndexStringPtr(c.value, fn)
+}
+
+func FindLastStringPtr(slice []*string, fn func(*string, int) bool) (res *string, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) FindLast(fn func(*string, int) bool) (*string, bool) {
+	return FindLastStringPtr(c.value, fn)
+}
+
+func FindLastIndexStringPtr(slice []*string, fn func(*string, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainStringPtr) FindLastIndex(fn func(*string, int) bool) int {
+	return FindLastIndexStringPtr(c.value, fn)
+}
+
+func IndexOfStringPtr(slice []*string, item *string) int {
+	for index, val := range slice {
+		if val == item || *val == *item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainStringPtr) IndexOf(item *string) int {
+	return IndexOfStringPtr(c.value, item)
+}
+
+func LastIndexOfStringPtr(slice []*string, item *string) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item || *val == *item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainStringPtr) LastIndexOf(item *string) int {
+	return LastIndexOfStringPtr(c.value, item)
+}
+
+func EveryStringPtr(slice []*string, fn func(*string, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainStringPtr) Every(fn func(*string, int) bool) bool {
+	return EveryStringPtr(c.value, fn)
+}
+
+func SomeStringPtr(slice []*string, fn func(*string, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainStringPtr) Some(fn func(*string, int) bool) bool {
+	return SomeStringPtr(c.value, fn)
+}
+
+func NoneStringPtr(slice []*string, fn func(*string, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainStringPtr) None(fn func(*string, int) bool) bool {
+	return NoneStringPtr(c.value, fn)
+}
+
+func CountStringPtr(slice []*string, fn func(*string, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) Count(fn func(*string, int) bool) int {
+	return CountStringPtr(c.value, fn)
+}
+
+func TakeStringPtr(slice []*string, n int) (res []*string) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*string, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainStringPtr) Take(n int) *chainStringPtr {
+	return &chainStringPtr{value: TakeStringPtr(c.value, n), err: c.err}
+}
+
+func TakeRightStringPtr(slice []*string, n int) (res []*string) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*string, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainStringPtr) TakeRight(n int) *chainStringPtr {
+	return &chainStringPtr{value: TakeRightStringPtr(c.value, n), err: c.err}
+}
+
+func TakeWhileStringPtr(slice []*string, fn func(*string, int) bool) (res []*string) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]*string, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainStringPtr) TakeWhile(fn func(*string, int) bool) *chainStringPtr {
+	return &chainStringPtr{value: TakeWhileStringPtr(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileStringPtr(slice []*string, fn func(*string, int) bool) (res []*string) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]*string, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainStringPtr) TakeRightWhile(fn func(*string, int) bool) *chainStringPtr {
+	return &chainStringPtr{value: TakeRightWhileStringPtr(c.value, fn), err: c.err}
+}
+
+func DropWhileStringPtr(slice []*string, fn func(*string, int) bool) (res []*string) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]*string, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainStringPtr) DropWhile(fn func(*string, int) bool) *chainStringPtr {
+	return &chainStringPtr{value: DropWhileStringPtr(c.value, fn), err: c.err}
+}
+
+func DropRightWhileStringPtr(slice []*string, fn func(*string, int) bool) (res []*string) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]*string, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainStringPtr) DropRightWhile(fn func(*string, int) bool) *chainStringPtr {
+	return &chainStringPtr{value: DropRightWhileStringPtr(c.value, fn), err: c.err}
+}
+
+func SliceStringPtr(slice []*string, start int, end int) (res []*string) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]*string, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainStringPtr) Slice(start int, end int) *chainStringPtr {
+	return &chainStringPtr{value: SliceStringPtr(c.value, start, end), err: c.err}
+}
+
+type chainStringPtrSlices struct {
+	value [][]*string
+	err   error
+}
+
+func NewStringPtrSlices(slices [][]*string) *chainStringPtrSlices {
+	return &chainStringPtrSlices{value: slices}
+}
+
+func (c *chainStringPtrSlices) Value() [][]*string {
+	return c.value
+}
+
+func (c *chainStringPtrSlices) Result() ([][]*string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainStringPtrSlices) Each(fn func([]*string, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainStringPtrSlices) Map(fn func([]*string, int) []*string) *chainStringPtrSlices {
+	res := make([][]*string, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainStringPtrSlices{value: res, err: c.err}
+}
+
+func (c *chainStringPtrSlices) Reduce(fn func(*string, *string, int) *string, initial *string) *chainStringPtr {
+	res := make([]*string, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainStringPtr{value: res, isPtr: true, err: c.err}
+}
+
+func ChunkStringPtr(slice []*string, size int) (res [][]*string) {
+	res = [][]*string{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]*string, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainStringPtr) Chunk(size int) *chainStringPtrSlices {
+	return &chainStringPtrSlices{value: ChunkStringPtr(c.value, size), err: c.err}
+}
+
+func WindowStringPtr(slice []*string, size int, step int) (res [][]*string) {
+	res = [][]*string{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]*string, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainStringPtr) Window(size int, step int) *chainStringPtrSlices {
+	return &chainStringPtrSlices{value: WindowStringPtr(c.value, size, step), err: c.err}
+}
+
+func FlattenStringPtr(slices [][]*string) (res []*string) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]*string, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainStringPtrSlices) Flatten() *chainStringPtr {
+	return &chainStringPtr{value: FlattenStringPtr(c.value), isPtr: true, err: c.err}
+}
+
+func FlatMapStringPtr(slice []*string, fn func(*string, int) []*string) (res []*string) {
+	mapped := make([][]*string, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]*string, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainStringPtr) FlatMap(fn func(*string, int) []*string) *chainStringPtr {
+	return &chainStringPtr{value: FlatMapStringPtr(c.value, fn), isPtr: true, err: c.err}
+}
+
+func MinStringPtr(slice []*string) (res *string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if *entry < *res {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainStringPtr) Min() (*string, bool) {
+	return MinStringPtr(c.value)
+}
+
+func MaxStringPtr(slice []*string) (res *string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if *res < *entry {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainStringPtr) Max() (*string, bool) {
+	return MaxStringPtr(c.value)
+}
+
+func MinByStringPtr(slice []*string, less func(a, b *string) bool) (res *string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainStringPtr) MinBy(less func(a, b *string) bool) (*string, bool) {
+	return MinByStringPtr(c.value, less)
+}
+
+func MaxByStringPtr(slice []*string, less func(a, b *string) bool) (res *string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainStringPtr) MaxBy(less func(a, b *string) bool) (*string, bool) {
+	return MaxByStringPtr(c.value, less)
+}
+
+func ShuffleStringPtr(slice []*string, rnd interface{ Intn(int) int }) (res []*string) {
+	res = make([]*string, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainStringPtr) Shuffle(rnd interface{ Intn(int) int }) *chainStringPtr {
+	return &chainStringPtr{value: ShuffleStringPtr(c.value, rnd), err: c.err}
+}
+
+func SampleStringPtr(slice []*string, rnd interface{ Intn(int) int }) (res *string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainStringPtr) Sample(rnd interface{ Intn(int) int }) (*string, bool) {
+	return SampleStringPtr(c.value, rnd)
+}
+
+func SampleNStringPtr(slice []*string, n int, rnd interface{ Intn(int) int }) (res []*string) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]*string, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) SampleN(n int, rnd interface{ Intn(int) int }) *chainStringPtr {
+	return &chainStringPtr{value: SampleNStringPtr(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceStringPtr(slice []*string, weight func(*string) float64, rnd interface{ Float64() float64 }) (res *string, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainStringPtr) WeightedChoice(weight func(*string) float64, rnd interface{ Float64() float64 }) (*string, bool) {
+	return WeightedChoiceStringPtr(c.value, weight, rnd)
+}
+
+// lazyStringPtrStep is a step of a lazyStringPtr. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyStringPtrStep struct {
+	start func() func(*string) (*string, bool, bool)
+	apply func([]*string) []*string
+}
+
+type lazyStringPtr struct {
+	isPtr  bool
+	err    error
+	source []*string
+	steps  []lazyStringPtrStep
+}
+
+func (c *chainStringPtr) Lazy() *lazyStringPtr {
+	return &lazyStringPtr{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyStringPtr) then(step lazyStringPtrStep) *lazyStringPtr {
+	// copied, so that chains can branch from c
+	steps := make([]lazyStringPtrStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyStringPtr{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyStringPtr) Filter(fn func(*string, int) bool) *lazyStringPtr {
+	return c.then(lazyStringPtrStep{start: func() func(*string) (*string, bool, bool) {
+		index := 0
+		return func(entry *string) (*string, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyStringPtr) Map(fn func(*string, int) *string) *lazyStringPtr {
+	return c.then(lazyStringPtrStep{start: func() func(*string) (*string, bool, bool) {
+		index := 0
+		return func(entry *string) (*string, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyStringPtr) Drop(n int) *lazyStringPtr {
+	return c.then(lazyStringPtrStep{start: func() func(*string) (*string, bool, bool) {
+		seen := 0
+		return func(entry *string) (*string, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyStringPtr) Take(n int) *lazyStringPtr {
+	return c.then(lazyStringPtrStep{start: func() func(*string) (*string, bool, bool) {
+		taken := 0
+		return func(entry *string) (*string, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyStringPtr) TakeWhile(fn func(*string, int) bool) *lazyStringPtr {
+	return c.then(lazyStringPtrStep{start: func() func(*string) (*string, bool, bool) {
+		index := 0
+		return func(entry *string) (*string, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyStringPtr) DropWhile(fn func(*string, int) bool) *lazyStringPtr {
+	return c.then(lazyStringPtrStep{start: func() func(*string) (*string, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry *string) (*string, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyStringPtr) Reverse() *lazyStringPtr {
+	return c.then(lazyStringPtrStep{apply: func(slice []*string) []*string {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyStringPtr) DropRight(n int) *lazyStringPtr {
+	return c.then(lazyStringPtrStep{apply: func(slice []*string) []*string {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyStringPtr) TakeRight(n int) *lazyStringPtr {
+	return c.then(lazyStringPtrStep{apply: func(slice []*string) []*string {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyStringPtr) Value() []*string {
+	res := make([]*string, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(*string) (*string, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyStringPtr) Eager() *chainStringPtr {
+	return &chainStringPtr{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelStringPtr calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelStringPtr(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachStringPtr(slice []*string, fn func(*string, int), workers int) {
+	runParallelStringPtr(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelStringPtr struct {
+	isPtr   bool
+	err     error
+	value   []*string
+	workers int
+}
+
+func (c *chainStringPtr) Parallel(workers int) *parallelStringPtr {
+	return &parallelStringPtr{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelStringPtr) ForEach(fn func(*string, int)) {
+	ParallelForEachStringPtr(c.value, fn, c.workers)
+}
+
+func (c *parallelStringPtr) Value() []*string {
+	return c.value
+}
+
+func (c *parallelStringPtr) Result() ([]*string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelStringPtr) Sequential() *chainStringPtr {
+	return &chainStringPtr{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapStringPtr(slice []*string, fn func(*string, int) *string, workers int) (res []*string) {
+	res = make([]*string, len(slice))
+	runParallelStringPtr(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelStringPtr) Map(fn func(*string, int) *string) *parallelStringPtr {
+	return &parallelStringPtr{value: ParallelMapStringPtr(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterStringPtr(slice []*string, fn func(*string, int) bool, workers int) (res []*string) {
+	keep := make([]bool, len(slice))
+	runParallelStringPtr(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]*string, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelStringPtr) Filter(fn func(*string, int) bool) *parallelStringPtr {
+	return &parallelStringPtr{value: ParallelFilterStringPtr(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrStringPtr(slice []*string, fn func(*string, int) (*string, error)) (res []*string, err error) {
+	res = make([]*string, 0, len(slice))
+	for index, entry := range slice {
+		var val *string
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainStringPtr) MapErr(fn func(*string, int) (*string, error)) *chainStringPtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrStringPtr(c.value, fn)
+	return &chainStringPtr{value: res, err: err}
+}
+
+func FilterErrStringPtr(slice []*string, fn func(*string, int) (bool, error)) (res []*string, err error) {
+	res = make([]*string, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainStringPtr) FilterErr(fn func(*string, int) (bool, error)) *chainStringPtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrStringPtr(c.value, fn)
+	return &chainStringPtr{value: res, err: err}
+}
+
+func ReduceErrStringPtr(slice []*string, fn func(*string, *string, int) (*string, error), initial *string) (res *string, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainStringPtr) ReduceErr(fn func(*string, *string, int) (*string, error), initial *string) *chainStringPtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrStringPtr(c.value, fn, initial)
+	if err != nil {
+		return &chainStringPtr{err: err}
+	}
+	return &chainStringPtr{value: []*string{res}}
+}
+
+func ForEachErrStringPtr(slice []*string, fn func(*string, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainStringPtr) ForEachErr(fn func(*string, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrStringPtr(c.value, fn)
+}
+
+func MapCtxStringPtr(ctx context.Context, slice []*string, fn func(*string, int) (*string, error)) ([]*string, error) {
+	return MapErrStringPtr(slice, func(entry *string, index int) (*string, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainStringPtr) MapCtx(ctx context.Context, fn func(*string, int) (*string, error)) *chainStringPtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxStringPtr(ctx, c.value, fn)
+	return &chainStringPtr{value: res, err: err}
+}
+
+func FilterCtxStringPtr(ctx context.Context, slice []*string, fn func(*string, int) (bool, error)) ([]*string, error) {
+	return FilterErrStringPtr(slice, func(entry *string, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainStringPtr) FilterCtx(ctx context.Context, fn func(*string, int) (bool, error)) *chainStringPtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxStringPtr(ctx, c.value, fn)
+	return &chainStringPtr{value: res, err: err}
+}
+
+func ReduceCtxStringPtr(ctx context.Context, slice []*string, fn func(*string, *string, int) (*string, error), initial *string) (*string, error) {
+	return ReduceErrStringPtr(slice, func(acc *string, entry *string, index int) (*string, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainStringPtr) ReduceCtx(ctx context.Context, fn func(*string, *string, int) (*string, error), initial *string) *chainStringPtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxStringPtr(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainStringPtr{err: err}
+	}
+	return &chainStringPtr{value: []*string{res}}
+}
+
+func ForEachCtxStringPtr(ctx context.Context, slice []*string, fn func(*string, int) error) error {
+	return ForEachErrStringPtr(slice, func(entry *string, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainStringPtr) ForEachCtx(ctx context.Context, fn func(*string, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxStringPtr(ctx, c.value, fn)
+}
+
+func ParallelMapCtxStringPtr(ctx context.Context, slice []*string, fn func(*string, int) (*string, error), workers int) ([]*string, error) {
+	res := make([]*string, len(slice))
+	err := runParallelStringPtr(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelStringPtr) MapCtx(ctx context.Context, fn func(*string, int) (*string, error)) *parallelStringPtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxStringPtr(ctx, c.value, fn, c.workers)
+	return &parallelStringPtr{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxStringPtr(ctx context.Context, slice []*string, fn func(*string, int) (bool, error), workers int) ([]*string, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelStringPtr(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*string, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelStringPtr) FilterCtx(ctx context.Context, fn func(*string, int) (bool, error)) *parallelStringPtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxStringPtr(ctx, c.value, fn, c.workers)
+	return &parallelStringPtr{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxStringPtr(ctx context.Context, slice []*string, fn func(*string, int) error, workers int) error {
+	return runParallelStringPtr(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelStringPtr) ForEachCtx(ctx context.Context, fn func(*string, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxStringPtr(ctx, c.value, fn, c.workers)
 }
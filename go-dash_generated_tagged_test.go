@@ -0,0 +1,2797 @@
+// This code is generated by https://github.com/jtyers/slice
+// DO NOT EDIT!
+
+package main
+
+import (
+	"context"
+	. "github.com/jtyers/slice/customtype"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+type chainTaggedType struct {
+	isPtr bool
+	value []TaggedType
+	err   error
+}
+
+func NewTaggedTypeSlice(slice []TaggedType) *chainTaggedType {
+	return &chainTaggedType{
+		value: slice,
+	}
+}
+
+func (c *chainTaggedType) Value() []TaggedType {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainTaggedType) Result() ([]TaggedType, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func ConcatTaggedType(slice []TaggedType, slice2 []TaggedType) (res []TaggedType) {
+	res = make([]TaggedType, 0, len(slice)+len(slice2))
+	for _, entry := range slice {
+		res = append(res, entry)
+	}
+	for _, entry := range slice2 {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainTaggedType) Concat(slice2 []TaggedType) *chainTaggedType {
+	return &chainTaggedType{value: ConcatTaggedType(c.value, slice2), err: c.err}
+}
+
+func ContainsTaggedType(slice []TaggedType, item TaggedType) (res bool) {
+	for _, val := range slice {
+		if val.Equal(item) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainTaggedType) Contains(item TaggedType) bool {
+	return ContainsTaggedType(c.value, item)
+}
+
+func DropTaggedType(slice []TaggedType, n int) (res []TaggedType) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]TaggedType, 0, l)
+	for _, entry := range slice[len(slice)-l:] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainTaggedType) Drop(n int) *chainTaggedType {
+	return &chainTaggedType{value: DropTaggedType(c.value, n), err: c.err}
+}
+
+func DropRightTaggedType(slice []TaggedType, n int) (res []TaggedType) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]TaggedType, 0, l)
+	for _, entry := range slice[:l] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainTaggedType) DropRight(n int) *chainTaggedType {
+	return &chainTaggedType{value: DropRightTaggedType(c.value, n), err: c.err}
+}
+
+func FilterTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) (res []TaggedType) {
+	res = make([]TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) Filter(fn func(TaggedType, int) bool) *chainTaggedType {
+	return &chainTaggedType{value: FilterTaggedType(c.value, fn), err: c.err}
+}
+
+func FirstTaggedType(slice []TaggedType) (res TaggedType) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	return
+}
+
+func (c *chainTaggedType) First() *chainTaggedType {
+	return &chainTaggedType{value: []TaggedType{FirstTaggedType(c.value)}, err: c.err}
+}
+
+func LastTaggedType(slice []TaggedType) (res TaggedType) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[len(slice)-1]
+	return
+}
+
+func (c *chainTaggedType) Last() *chainTaggedType {
+	return &chainTaggedType{value: []TaggedType{LastTaggedType(c.value)}, err: c.err}
+}
+
+func MapTaggedType(slice []TaggedType, fn func(TaggedType, int) TaggedType) (res []TaggedType) {
+	res = make([]TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainTaggedType) Map(fn func(TaggedType, int) TaggedType) *chainTaggedType {
+	return &chainTaggedType{value: MapTaggedType(c.value, fn), err: c.err}
+}
+
+func ReduceTaggedType(slice []TaggedType, fn func(TaggedType, TaggedType, int) TaggedType, initial TaggedType) (res TaggedType) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainTaggedType) Reduce(fn func(TaggedType, TaggedType, int) TaggedType, initial TaggedType) *chainTaggedType {
+	return &chainTaggedType{value: []TaggedType{ReduceTaggedType(c.value, fn, initial)}, err: c.err}
+}
+
+func ReverseTaggedType(slice []TaggedType) (res []TaggedType) {
+	res = make([]TaggedType, len(slice))
+	for index, entry := range slice {
+		res[len(slice)-1-index] = entry
+	}
+	return
+}
+
+func (c *chainTaggedType) Reverse() *chainTaggedType {
+	return &chainTaggedType{value: ReverseTaggedType(c.value), err: c.err}
+}
+
+func UniqTaggedType(slice []TaggedType) (res []TaggedType) {
+	res = []TaggedType{}
+outer:
+	for _, entry := range slice {
+		for _, seen := range res {
+			if entry.Equal(seen) {
+				continue outer
+			}
+		}
+		res = append(res, entry)
+	}
+	return
+
+}
+
+func (c *chainTaggedType) Uniq() *chainTaggedType {
+	if c.isPtr {
+		panic("Uniq() does not currently support pointers")
+	}
+	return &chainTaggedType{value: UniqTaggedType(c.value), err: c.err}
+}
+
+func SortByTaggedType(slice []TaggedType, less func(a, b TaggedType) bool) (res []TaggedType) {
+	res = make([]TaggedType, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainTaggedType) SortBy(less func(a, b TaggedType) bool) *chainTaggedType {
+	return &chainTaggedType{value: SortByTaggedType(c.value, less), err: c.err}
+}
+
+func SortStableByTaggedType(slice []TaggedType, less func(a, b TaggedType) bool) (res []TaggedType) {
+	res = make([]TaggedType, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainTaggedType) SortStableBy(less func(a, b TaggedType) bool) *chainTaggedType {
+	return &chainTaggedType{value: SortStableByTaggedType(c.value, less), err: c.err}
+}
+
+func IsSortedByTaggedType(slice []TaggedType, less func(a, b TaggedType) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedType) IsSortedBy(less func(a, b TaggedType) bool) bool {
+	return IsSortedByTaggedType(c.value, less)
+}
+
+func UnionTaggedType(slice []TaggedType, slice2 []TaggedType) (res []TaggedType) {
+	res = []TaggedType{}
+	contains := func(slice []TaggedType, item TaggedType) bool {
+		for _, val := range slice {
+			if val.Equal(item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) Union(slice2 []TaggedType) *chainTaggedType {
+	return &chainTaggedType{value: UnionTaggedType(c.value, slice2), err: c.err}
+}
+
+func IntersectionTaggedType(slice []TaggedType, slice2 []TaggedType) (res []TaggedType) {
+	res = []TaggedType{}
+	contains := func(slice []TaggedType, item TaggedType) bool {
+		for _, val := range slice {
+			if val.Equal(item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if contains(slice2, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) Intersection(slice2 []TaggedType) *chainTaggedType {
+	return &chainTaggedType{value: IntersectionTaggedType(c.value, slice2), err: c.err}
+}
+
+func DifferenceTaggedType(slice []TaggedType, slice2 []TaggedType) (res []TaggedType) {
+	res = []TaggedType{}
+	contains := func(slice []TaggedType, item TaggedType) bool {
+		for _, val := range slice {
+			if val.Equal(item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if !contains(slice2, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) Difference(slice2 []TaggedType) *chainTaggedType {
+	return &chainTaggedType{value: DifferenceTaggedType(c.value, slice2), err: c.err}
+}
+
+func XorTaggedType(slice []TaggedType, slice2 []TaggedType) (res []TaggedType) {
+	res = []TaggedType{}
+	contains := func(slice []TaggedType, item TaggedType) bool {
+		for _, val := range slice {
+			if val.Equal(item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if !contains(slice2, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !contains(slice, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) Xor(slice2 []TaggedType) *chainTaggedType {
+	return &chainTaggedType{value: XorTaggedType(c.value, slice2), err: c.err}
+}
+
+func IsSubsetTaggedType(slice []TaggedType, slice2 []TaggedType) bool {
+	contains := func(slice []TaggedType, item TaggedType) bool {
+		for _, val := range slice {
+			if val.Equal(item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if !contains(slice2, entry) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedType) IsSubset(slice2 []TaggedType) bool {
+	return IsSubsetTaggedType(c.value, slice2)
+}
+
+func IsDisjointTaggedType(slice []TaggedType, slice2 []TaggedType) bool {
+	contains := func(slice []TaggedType, item TaggedType) bool {
+		for _, val := range slice {
+			if val.Equal(item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if contains(slice2, entry) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedType) IsDisjoint(slice2 []TaggedType) bool {
+	return IsDisjointTaggedType(c.value, slice2)
+}
+
+func PartitionTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) (yes []TaggedType, no []TaggedType) {
+	yes = []TaggedType{}
+	no = []TaggedType{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) Partition(fn func(TaggedType, int) bool) ([]TaggedType, []TaggedType) {
+	return PartitionTaggedType(c.value, fn)
+}
+
+func FindTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) (res TaggedType, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) Find(fn func(TaggedType, int) bool) (TaggedType, bool) {
+	return FindTaggedType(c.value, fn)
+}
+
+func FindIndexTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainTaggedType) FindIndex(fn func(TaggedType, int) bool) int {
+	return FindIndexTaggedType(c.value, fn)
+}
+
+func FindLastTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) (res TaggedType, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) FindLast(fn func(TaggedType, int) bool) (TaggedType, bool) {
+	return FindLastTaggedType(c.value, fn)
+}
+
+func FindLastIndexTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainTaggedType) FindLastIndex(fn func(TaggedType, int) bool) int {
+	return FindLastIndexTaggedType(c.value, fn)
+}
+
+func IndexOfTaggedType(slice []TaggedType, item TaggedType) int {
+	for index, val := range slice {
+		if val.Equal(item) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainTaggedType) IndexOf(item TaggedType) int {
+	return IndexOfTaggedType(c.value, item)
+}
+
+func LastIndexOfTaggedType(slice []TaggedType, item TaggedType) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val.Equal(item) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainTaggedType) LastIndexOf(item TaggedType) int {
+	return LastIndexOfTaggedType(c.value, item)
+}
+
+func EveryTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedType) Every(fn func(TaggedType, int) bool) bool {
+	return EveryTaggedType(c.value, fn)
+}
+
+func SomeTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainTaggedType) Some(fn func(TaggedType, int) bool) bool {
+	return SomeTaggedType(c.value, fn)
+}
+
+func NoneTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedType) None(fn func(TaggedType, int) bool) bool {
+	return NoneTaggedType(c.value, fn)
+}
+
+func CountTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) Count(fn func(TaggedType, int) bool) int {
+	return CountTaggedType(c.value, fn)
+}
+
+func TakeTaggedType(slice []TaggedType, n int) (res []TaggedType) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]TaggedType, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainTaggedType) Take(n int) *chainTaggedType {
+	return &chainTaggedType{value: TakeTaggedType(c.value, n), err: c.err}
+}
+
+func TakeRightTaggedType(slice []TaggedType, n int) (res []TaggedType) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]TaggedType, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainTaggedType) TakeRight(n int) *chainTaggedType {
+	return &chainTaggedType{value: TakeRightTaggedType(c.value, n), err: c.err}
+}
+
+func TakeWhileTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) (res []TaggedType) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]TaggedType, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainTaggedType) TakeWhile(fn func(TaggedType, int) bool) *chainTaggedType {
+	return &chainTaggedType{value: TakeWhileTaggedType(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) (res []TaggedType) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]TaggedType, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainTaggedType) TakeRightWhile(fn func(TaggedType, int) bool) *chainTaggedType {
+	return &chainTaggedType{value: TakeRightWhileTaggedType(c.value, fn), err: c.err}
+}
+
+func DropWhileTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) (res []TaggedType) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]TaggedType, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainTaggedType) DropWhile(fn func(TaggedType, int) bool) *chainTaggedType {
+	return &chainTaggedType{value: DropWhileTaggedType(c.value, fn), err: c.err}
+}
+
+func DropRightWhileTaggedType(slice []TaggedType, fn func(TaggedType, int) bool) (res []TaggedType) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]TaggedType, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainTaggedType) DropRightWhile(fn func(TaggedType, int) bool) *chainTaggedType {
+	return &chainTaggedType{value: DropRightWhileTaggedType(c.value, fn), err: c.err}
+}
+
+func SliceTaggedType(slice []TaggedType, start int, end int) (res []TaggedType) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]TaggedType, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainTaggedType) Slice(start int, end int) *chainTaggedType {
+	return &chainTaggedType{value: SliceTaggedType(c.value, start, end), err: c.err}
+}
+
+type chainTaggedTypeSlices struct {
+	value [][]TaggedType
+	err   error
+}
+
+func NewTaggedTypeSlices(slices [][]TaggedType) *chainTaggedTypeSlices {
+	return &chainTaggedTypeSlices{value: slices}
+}
+
+func (c *chainTaggedTypeSlices) Value() [][]TaggedType {
+	return c.value
+}
+
+func (c *chainTaggedTypeSlices) Result() ([][]TaggedType, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainTaggedTypeSlices) Each(fn func([]TaggedType, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainTaggedTypeSlices) Map(fn func([]TaggedType, int) []TaggedType) *chainTaggedTypeSlices {
+	res := make([][]TaggedType, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainTaggedTypeSlices{value: res, err: c.err}
+}
+
+func (c *chainTaggedTypeSlices) Reduce(fn func(TaggedType, TaggedType, int) TaggedType, initial TaggedType) *chainTaggedType {
+	res := make([]TaggedType, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainTaggedType{value: res, err: c.err}
+}
+
+func ChunkTaggedType(slice []TaggedType, size int) (res [][]TaggedType) {
+	res = [][]TaggedType{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]TaggedType, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainTaggedType) Chunk(size int) *chainTaggedTypeSlices {
+	return &chainTaggedTypeSlices{value: ChunkTaggedType(c.value, size), err: c.err}
+}
+
+func WindowTaggedType(slice []TaggedType, size int, step int) (res [][]TaggedType) {
+	res = [][]TaggedType{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]TaggedType, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainTaggedType) Window(size int, step int) *chainTaggedTypeSlices {
+	return &chainTaggedTypeSlices{value: WindowTaggedType(c.value, size, step), err: c.err}
+}
+
+func FlattenTaggedType(slices [][]TaggedType) (res []TaggedType) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]TaggedType, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainTaggedTypeSlices) Flatten() *chainTaggedType {
+	return &chainTaggedType{value: FlattenTaggedType(c.value), err: c.err}
+}
+
+func FlatMapTaggedType(slice []TaggedType, fn func(TaggedType, int) []TaggedType) (res []TaggedType) {
+	mapped := make([][]TaggedType, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]TaggedType, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainTaggedType) FlatMap(fn func(TaggedType, int) []TaggedType) *chainTaggedType {
+	return &chainTaggedType{value: FlatMapTaggedType(c.value, fn), err: c.err}
+}
+
+func MinByTaggedType(slice []TaggedType, less func(a, b TaggedType) bool) (res TaggedType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainTaggedType) MinBy(less func(a, b TaggedType) bool) (TaggedType, bool) {
+	return MinByTaggedType(c.value, less)
+}
+
+func MaxByTaggedType(slice []TaggedType, less func(a, b TaggedType) bool) (res TaggedType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainTaggedType) MaxBy(less func(a, b TaggedType) bool) (TaggedType, bool) {
+	return MaxByTaggedType(c.value, less)
+}
+
+func ShuffleTaggedType(slice []TaggedType, rnd interface{ Intn(int) int }) (res []TaggedType) {
+	res = make([]TaggedType, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainTaggedType) Shuffle(rnd interface{ Intn(int) int }) *chainTaggedType {
+	return &chainTaggedType{value: ShuffleTaggedType(c.value, rnd), err: c.err}
+}
+
+func SampleTaggedType(slice []TaggedType, rnd interface{ Intn(int) int }) (res TaggedType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainTaggedType) Sample(rnd interface{ Intn(int) int }) (TaggedType, bool) {
+	return SampleTaggedType(c.value, rnd)
+}
+
+func SampleNTaggedType(slice []TaggedType, n int, rnd interface{ Intn(int) int }) (res []TaggedType) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]TaggedType, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) SampleN(n int, rnd interface{ Intn(int) int }) *chainTaggedType {
+	return &chainTaggedType{value: SampleNTaggedType(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceTaggedType(slice []TaggedType, weight func(TaggedType) float64, rnd interface{ Float64() float64 }) (res TaggedType, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainTaggedType) WeightedChoice(weight func(TaggedType) float64, rnd interface{ Float64() float64 }) (TaggedType, bool) {
+	return WeightedChoiceTaggedType(c.value, weight, rnd)
+}
+
+// lazyTaggedTypeStep is a step of a lazyTaggedType. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyTaggedTypeStep struct {
+	start func() func(TaggedType) (TaggedType, bool, bool)
+	apply func([]TaggedType) []TaggedType
+}
+
+type lazyTaggedType struct {
+	isPtr  bool
+	err    error
+	source []TaggedType
+	steps  []lazyTaggedTypeStep
+}
+
+func (c *chainTaggedType) Lazy() *lazyTaggedType {
+	return &lazyTaggedType{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyTaggedType) then(step lazyTaggedTypeStep) *lazyTaggedType {
+	// copied, so that chains can branch from c
+	steps := make([]lazyTaggedTypeStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyTaggedType{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyTaggedType) Filter(fn func(TaggedType, int) bool) *lazyTaggedType {
+	return c.then(lazyTaggedTypeStep{start: func() func(TaggedType) (TaggedType, bool, bool) {
+		index := 0
+		return func(entry TaggedType) (TaggedType, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyTaggedType) Map(fn func(TaggedType, int) TaggedType) *lazyTaggedType {
+	return c.then(lazyTaggedTypeStep{start: func() func(TaggedType) (TaggedType, bool, bool) {
+		index := 0
+		return func(entry TaggedType) (TaggedType, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyTaggedType) Drop(n int) *lazyTaggedType {
+	return c.then(lazyTaggedTypeStep{start: func() func(TaggedType) (TaggedType, bool, bool) {
+		seen := 0
+		return func(entry TaggedType) (TaggedType, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyTaggedType) Take(n int) *lazyTaggedType {
+	return c.then(lazyTaggedTypeStep{start: func() func(TaggedType) (TaggedType, bool, bool) {
+		taken := 0
+		return func(entry TaggedType) (TaggedType, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyTaggedType) TakeWhile(fn func(TaggedType, int) bool) *lazyTaggedType {
+	return c.then(lazyTaggedTypeStep{start: func() func(TaggedType) (TaggedType, bool, bool) {
+		index := 0
+		return func(entry TaggedType) (TaggedType, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyTaggedType) DropWhile(fn func(TaggedType, int) bool) *lazyTaggedType {
+	return c.then(lazyTaggedTypeStep{start: func() func(TaggedType) (TaggedType, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry TaggedType) (TaggedType, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyTaggedType) Reverse() *lazyTaggedType {
+	return c.then(lazyTaggedTypeStep{apply: func(slice []TaggedType) []TaggedType {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyTaggedType) DropRight(n int) *lazyTaggedType {
+	return c.then(lazyTaggedTypeStep{apply: func(slice []TaggedType) []TaggedType {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyTaggedType) TakeRight(n int) *lazyTaggedType {
+	return c.then(lazyTaggedTypeStep{apply: func(slice []TaggedType) []TaggedType {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyTaggedType) Value() []TaggedType {
+	res := make([]TaggedType, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(TaggedType) (TaggedType, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyTaggedType) Eager() *chainTaggedType {
+	return &chainTaggedType{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelTaggedType calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelTaggedType(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachTaggedType(slice []TaggedType, fn func(TaggedType, int), workers int) {
+	runParallelTaggedType(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelTaggedType struct {
+	isPtr   bool
+	err     error
+	value   []TaggedType
+	workers int
+}
+
+func (c *chainTaggedType) Parallel(workers int) *parallelTaggedType {
+	return &parallelTaggedType{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelTaggedType) ForEach(fn func(TaggedType, int)) {
+	ParallelForEachTaggedType(c.value, fn, c.workers)
+}
+
+func (c *parallelTaggedType) Value() []TaggedType {
+	return c.value
+}
+
+func (c *parallelTaggedType) Result() ([]TaggedType, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelTaggedType) Sequential() *chainTaggedType {
+	return &chainTaggedType{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapTaggedType(slice []TaggedType, fn func(TaggedType, int) TaggedType, workers int) (res []TaggedType) {
+	res = make([]TaggedType, len(slice))
+	runParallelTaggedType(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelTaggedType) Map(fn func(TaggedType, int) TaggedType) *parallelTaggedType {
+	return &parallelTaggedType{value: ParallelMapTaggedType(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterTaggedType(slice []TaggedType, fn func(TaggedType, int) bool, workers int) (res []TaggedType) {
+	keep := make([]bool, len(slice))
+	runParallelTaggedType(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelTaggedType) Filter(fn func(TaggedType, int) bool) *parallelTaggedType {
+	return &parallelTaggedType{value: ParallelFilterTaggedType(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrTaggedType(slice []TaggedType, fn func(TaggedType, int) (TaggedType, error)) (res []TaggedType, err error) {
+	res = make([]TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		var val TaggedType
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainTaggedType) MapErr(fn func(TaggedType, int) (TaggedType, error)) *chainTaggedType {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrTaggedType(c.value, fn)
+	return &chainTaggedType{value: res, err: err}
+}
+
+func FilterErrTaggedType(slice []TaggedType, fn func(TaggedType, int) (bool, error)) (res []TaggedType, err error) {
+	res = make([]TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedType) FilterErr(fn func(TaggedType, int) (bool, error)) *chainTaggedType {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrTaggedType(c.value, fn)
+	return &chainTaggedType{value: res, err: err}
+}
+
+func ReduceErrTaggedType(slice []TaggedType, fn func(TaggedType, TaggedType, int) (TaggedType, error), initial TaggedType) (res TaggedType, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainTaggedType) ReduceErr(fn func(TaggedType, TaggedType, int) (TaggedType, error), initial TaggedType) *chainTaggedType {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrTaggedType(c.value, fn, initial)
+	if err != nil {
+		return &chainTaggedType{err: err}
+	}
+	return &chainTaggedType{value: []TaggedType{res}}
+}
+
+func ForEachErrTaggedType(slice []TaggedType, fn func(TaggedType, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainTaggedType) ForEachErr(fn func(TaggedType, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrTaggedType(c.value, fn)
+}
+
+func MapCtxTaggedType(ctx context.Context, slice []TaggedType, fn func(TaggedType, int) (TaggedType, error)) ([]TaggedType, error) {
+	return MapErrTaggedType(slice, func(entry TaggedType, index int) (TaggedType, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainTaggedType) MapCtx(ctx context.Context, fn func(TaggedType, int) (TaggedType, error)) *chainTaggedType {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxTaggedType(ctx, c.value, fn)
+	return &chainTaggedType{value: res, err: err}
+}
+
+func FilterCtxTaggedType(ctx context.Context, slice []TaggedType, fn func(TaggedType, int) (bool, error)) ([]TaggedType, error) {
+	return FilterErrTaggedType(slice, func(entry TaggedType, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainTaggedType) FilterCtx(ctx context.Context, fn func(TaggedType, int) (bool, error)) *chainTaggedType {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxTaggedType(ctx, c.value, fn)
+	return &chainTaggedType{value: res, err: err}
+}
+
+func ReduceCtxTaggedType(ctx context.Context, slice []TaggedType, fn func(TaggedType, TaggedType, int) (TaggedType, error), initial TaggedType) (TaggedType, error) {
+	return ReduceErrTaggedType(slice, func(acc TaggedType, entry TaggedType, index int) (TaggedType, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainTaggedType) ReduceCtx(ctx context.Context, fn func(TaggedType, TaggedType, int) (TaggedType, error), initial TaggedType) *chainTaggedType {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxTaggedType(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainTaggedType{err: err}
+	}
+	return &chainTaggedType{value: []TaggedType{res}}
+}
+
+func ForEachCtxTaggedType(ctx context.Context, slice []TaggedType, fn func(TaggedType, int) error) error {
+	return ForEachErrTaggedType(slice, func(entry TaggedType, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainTaggedType) ForEachCtx(ctx context.Context, fn func(TaggedType, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxTaggedType(ctx, c.value, fn)
+}
+
+func ParallelMapCtxTaggedType(ctx context.Context, slice []TaggedType, fn func(TaggedType, int) (TaggedType, error), workers int) ([]TaggedType, error) {
+	res := make([]TaggedType, len(slice))
+	err := runParallelTaggedType(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelTaggedType) MapCtx(ctx context.Context, fn func(TaggedType, int) (TaggedType, error)) *parallelTaggedType {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxTaggedType(ctx, c.value, fn, c.workers)
+	return &parallelTaggedType{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxTaggedType(ctx context.Context, slice []TaggedType, fn func(TaggedType, int) (bool, error), workers int) ([]TaggedType, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelTaggedType(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelTaggedType) FilterCtx(ctx context.Context, fn func(TaggedType, int) (bool, error)) *parallelTaggedType {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxTaggedType(ctx, c.value, fn, c.workers)
+	return &parallelTaggedType{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxTaggedType(ctx context.Context, slice []TaggedType, fn func(TaggedType, int) error, workers int) error {
+	return runParallelTaggedType(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelTaggedType) ForEachCtx(ctx context.Context, fn func(TaggedType, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxTaggedType(ctx, c.value, fn, c.workers)
+}
+
+type chainTaggedTypePtr struct {
+	isPtr bool
+	value []*TaggedType
+	err   error
+}
+
+func NewTaggedTypePtrSlice(slice []*TaggedType) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{
+		value: slice,
+		isPtr: true,
+	}
+}
+
+func (c *chainTaggedTypePtr) Value() []*TaggedType {
+	return c.value
+}
+
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainTaggedTypePtr) Result() ([]*TaggedType, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func ConcatTaggedTypePtr(slice []*TaggedType, slice2 []*TaggedType) (res []*TaggedType) {
+	res = make([]*TaggedType, 0, len(slice)+len(slice2))
+	for _, entry := range slice {
+		res = append(res, entry)
+	}
+	for _, entry := range slice2 {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Concat(slice2 []*TaggedType) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: ConcatTaggedTypePtr(c.value, slice2), err: c.err}
+}
+
+func ContainsTaggedTypePtr(slice []*TaggedType, item *TaggedType) (res bool) {
+	for _, val := range slice {
+		if val == item || val.Equal(*item) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainTaggedTypePtr) Contains(item *TaggedType) bool {
+	return ContainsTaggedTypePtr(c.value, item)
+}
+
+func DropTaggedTypePtr(slice []*TaggedType, n int) (res []*TaggedType) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*TaggedType, 0, l)
+	for _, entry := range slice[len(slice)-l:] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Drop(n int) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: DropTaggedTypePtr(c.value, n), err: c.err}
+}
+
+func DropRightTaggedTypePtr(slice []*TaggedType, n int) (res []*TaggedType) {
+	l := len(slice) - n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*TaggedType, 0, l)
+	for _, entry := range slice[:l] {
+		res = append(res, entry)
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) DropRight(n int) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: DropRightTaggedTypePtr(c.value, n), err: c.err}
+}
+
+func FilterTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) (res []*TaggedType) {
+	res = make([]*TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Filter(fn func(*TaggedType, int) bool) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: FilterTaggedTypePtr(c.value, fn), err: c.err}
+}
+
+func FirstTaggedTypePtr(slice []*TaggedType) (res *TaggedType) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	return
+}
+
+func (c *chainTaggedTypePtr) First() *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: []*TaggedType{FirstTaggedTypePtr(c.value)}, err: c.err}
+}
+
+func LastTaggedTypePtr(slice []*TaggedType) (res *TaggedType) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[len(slice)-1]
+	return
+}
+
+func (c *chainTaggedTypePtr) Last() *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: []*TaggedType{LastTaggedTypePtr(c.value)}, err: c.err}
+}
+
+func MapTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) *TaggedType) (res []*TaggedType) {
+	res = make([]*TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Map(fn func(*TaggedType, int) *TaggedType) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: MapTaggedTypePtr(c.value, fn), err: c.err}
+}
+
+func ReduceTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, *TaggedType, int) *TaggedType, initial *TaggedType) (res *TaggedType) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Reduce(fn func(*TaggedType, *TaggedType, int) *TaggedType, initial *TaggedType) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: []*TaggedType{ReduceTaggedTypePtr(c.value, fn, initial)}, err: c.err}
+}
+
+func ReverseTaggedTypePtr(slice []*TaggedType) (res []*TaggedType) {
+	res = make([]*TaggedType, len(slice))
+	for index, entry := range slice {
+		res[len(slice)-1-index] = entry
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Reverse() *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: ReverseTaggedTypePtr(c.value), err: c.err}
+}
+
+func UniqTaggedTypePtr(slice []*TaggedType) (res []*TaggedType) {
+	seen := make(map[*TaggedType]bool)
+	res = []*TaggedType{}
+	for _, entry := range slice {
+		if _, found := seen[entry]; !found {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+
+}
+
+func (c *chainTaggedTypePtr) Uniq() *chainTaggedTypePtr {
+	if c.isPtr {
+		panic("Uniq() does not currently support pointers")
+	}
+	return &chainTaggedTypePtr{value: UniqTaggedTypePtr(c.value), err: c.err}
+}
+
+func SortByTaggedTypePtr(slice []*TaggedType, less func(a, b *TaggedType) bool) (res []*TaggedType) {
+	res = make([]*TaggedType, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainTaggedTypePtr) SortBy(less func(a, b *TaggedType) bool) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: SortByTaggedTypePtr(c.value, less), err: c.err}
+}
+
+func SortStableByTaggedTypePtr(slice []*TaggedType, less func(a, b *TaggedType) bool) (res []*TaggedType) {
+	res = make([]*TaggedType, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainTaggedTypePtr) SortStableBy(less func(a, b *TaggedType) bool) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: SortStableByTaggedTypePtr(c.value, less), err: c.err}
+}
+
+func IsSortedByTaggedTypePtr(slice []*TaggedType, less func(a, b *TaggedType) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedTypePtr) IsSortedBy(less func(a, b *TaggedType) bool) bool {
+	return IsSortedByTaggedTypePtr(c.value, less)
+}
+
+func UnionTaggedTypePtr(slice []*TaggedType, slice2 []*TaggedType) (res []*TaggedType) {
+	res = []*TaggedType{}
+	contains := func(slice []*TaggedType, item *TaggedType) bool {
+		for _, val := range slice {
+			if val == item || val.Equal(*item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Union(slice2 []*TaggedType) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: UnionTaggedTypePtr(c.value, slice2), err: c.err}
+}
+
+func IntersectionTaggedTypePtr(slice []*TaggedType, slice2 []*TaggedType) (res []*TaggedType) {
+	res = []*TaggedType{}
+	contains := func(slice []*TaggedType, item *TaggedType) bool {
+		for _, val := range slice {
+			if val == item || val.Equal(*item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if contains(slice2, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Intersection(slice2 []*TaggedType) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: IntersectionTaggedTypePtr(c.value, slice2), err: c.err}
+}
+
+func DifferenceTaggedTypePtr(slice []*TaggedType, slice2 []*TaggedType) (res []*TaggedType) {
+	res = []*TaggedType{}
+	contains := func(slice []*TaggedType, item *TaggedType) bool {
+		for _, val := range slice {
+			if val == item || val.Equal(*item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if !contains(slice2, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Difference(slice2 []*TaggedType) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: DifferenceTaggedTypePtr(c.value, slice2), err: c.err}
+}
+
+func XorTaggedTypePtr(slice []*TaggedType, slice2 []*TaggedType) (res []*TaggedType) {
+	res = []*TaggedType{}
+	contains := func(slice []*TaggedType, item *TaggedType) bool {
+		for _, val := range slice {
+			if val == item || val.Equal(*item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if !contains(slice2, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !contains(slice, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Xor(slice2 []*TaggedType) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: XorTaggedTypePtr(c.value, slice2), err: c.err}
+}
+
+func IsSubsetTaggedTypePtr(slice []*TaggedType, slice2 []*TaggedType) bool {
+	contains := func(slice []*TaggedType, item *TaggedType) bool {
+		for _, val := range slice {
+			if val == item || val.Equal(*item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if !contains(slice2, entry) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedTypePtr) IsSubset(slice2 []*TaggedType) bool {
+	return IsSubsetTaggedTypePtr(c.value, slice2)
+}
+
+func IsDisjointTaggedTypePtr(slice []*TaggedType, slice2 []*TaggedType) bool {
+	contains := func(slice []*TaggedType, item *TaggedType) bool {
+		for _, val := range slice {
+			if val == item || val.Equal(*item) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, entry := range slice {
+		if contains(slice2, entry) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedTypePtr) IsDisjoint(slice2 []*TaggedType) bool {
+	return IsDisjointTaggedTypePtr(c.value, slice2)
+}
+
+func PartitionTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) (yes []*TaggedType, no []*TaggedType) {
+	yes = []*TaggedType{}
+	no = []*TaggedType{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Partition(fn func(*TaggedType, int) bool) ([]*TaggedType, []*TaggedType) {
+	return PartitionTaggedTypePtr(c.value, fn)
+}
+
+func FindTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) (res *TaggedType, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Find(fn func(*TaggedType, int) bool) (*TaggedType, bool) {
+	return FindTaggedTypePtr(c.value, fn)
+}
+
+func FindIndexTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainTaggedTypePtr) FindIndex(fn func(*TaggedType, int) bool) int {
+	return FindIndexTaggedTypePtr(c.value, fn)
+}
+
+func FindLastTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) (res *TaggedType, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) FindLast(fn func(*TaggedType, int) bool) (*TaggedType, bool) {
+	return FindLastTaggedTypePtr(c.value, fn)
+}
+
+func FindLastIndexTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainTaggedTypePtr) FindLastIndex(fn func(*TaggedType, int) bool) int {
+	return FindLastIndexTaggedTypePtr(c.value, fn)
+}
+
+func IndexOfTaggedTypePtr(slice []*TaggedType, item *TaggedType) int {
+	for index, val := range slice {
+		if val == item || val.Equal(*item) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainTaggedTypePtr) IndexOf(item *TaggedType) int {
+	return IndexOfTaggedTypePtr(c.value, item)
+}
+
+func LastIndexOfTaggedTypePtr(slice []*TaggedType, item *TaggedType) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item || val.Equal(*item) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainTaggedTypePtr) LastIndexOf(item *TaggedType) int {
+	return LastIndexOfTaggedTypePtr(c.value, item)
+}
+
+func EveryTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedTypePtr) Every(fn func(*TaggedType, int) bool) bool {
+	return EveryTaggedTypePtr(c.value, fn)
+}
+
+func SomeTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainTaggedTypePtr) Some(fn func(*TaggedType, int) bool) bool {
+	return SomeTaggedTypePtr(c.value, fn)
+}
+
+func NoneTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainTaggedTypePtr) None(fn func(*TaggedType, int) bool) bool {
+	return NoneTaggedTypePtr(c.value, fn)
+}
+
+func CountTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Count(fn func(*TaggedType, int) bool) int {
+	return CountTaggedTypePtr(c.value, fn)
+}
+
+func TakeTaggedTypePtr(slice []*TaggedType, n int) (res []*TaggedType) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*TaggedType, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainTaggedTypePtr) Take(n int) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: TakeTaggedTypePtr(c.value, n), err: c.err}
+}
+
+func TakeRightTaggedTypePtr(slice []*TaggedType, n int) (res []*TaggedType) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]*TaggedType, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainTaggedTypePtr) TakeRight(n int) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: TakeRightTaggedTypePtr(c.value, n), err: c.err}
+}
+
+func TakeWhileTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) (res []*TaggedType) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]*TaggedType, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainTaggedTypePtr) TakeWhile(fn func(*TaggedType, int) bool) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: TakeWhileTaggedTypePtr(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) (res []*TaggedType) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]*TaggedType, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainTaggedTypePtr) TakeRightWhile(fn func(*TaggedType, int) bool) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: TakeRightWhileTaggedTypePtr(c.value, fn), err: c.err}
+}
+
+func DropWhileTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) (res []*TaggedType) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]*TaggedType, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainTaggedTypePtr) DropWhile(fn func(*TaggedType, int) bool) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: DropWhileTaggedTypePtr(c.value, fn), err: c.err}
+}
+
+func DropRightWhileTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool) (res []*TaggedType) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]*TaggedType, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainTaggedTypePtr) DropRightWhile(fn func(*TaggedType, int) bool) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: DropRightWhileTaggedTypePtr(c.value, fn), err: c.err}
+}
+
+func SliceTaggedTypePtr(slice []*TaggedType, start int, end int) (res []*TaggedType) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]*TaggedType, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainTaggedTypePtr) Slice(start int, end int) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: SliceTaggedTypePtr(c.value, start, end), err: c.err}
+}
+
+type chainTaggedTypePtrSlices struct {
+	value [][]*TaggedType
+	err   error
+}
+
+func NewTaggedTypePtrSlices(slices [][]*TaggedType) *chainTaggedTypePtrSlices {
+	return &chainTaggedTypePtrSlices{value: slices}
+}
+
+func (c *chainTaggedTypePtrSlices) Value() [][]*TaggedType {
+	return c.value
+}
+
+func (c *chainTaggedTypePtrSlices) Result() ([][]*TaggedType, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainTaggedTypePtrSlices) Each(fn func([]*TaggedType, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainTaggedTypePtrSlices) Map(fn func([]*TaggedType, int) []*TaggedType) *chainTaggedTypePtrSlices {
+	res := make([][]*TaggedType, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainTaggedTypePtrSlices{value: res, err: c.err}
+}
+
+func (c *chainTaggedTypePtrSlices) Reduce(fn func(*TaggedType, *TaggedType, int) *TaggedType, initial *TaggedType) *chainTaggedTypePtr {
+	res := make([]*TaggedType, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainTaggedTypePtr{value: res, isPtr: true, err: c.err}
+}
+
+func ChunkTaggedTypePtr(slice []*TaggedType, size int) (res [][]*TaggedType) {
+	res = [][]*TaggedType{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]*TaggedType, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Chunk(size int) *chainTaggedTypePtrSlices {
+	return &chainTaggedTypePtrSlices{value: ChunkTaggedTypePtr(c.value, size), err: c.err}
+}
+
+func WindowTaggedTypePtr(slice []*TaggedType, size int, step int) (res [][]*TaggedType) {
+	res = [][]*TaggedType{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]*TaggedType, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Window(size int, step int) *chainTaggedTypePtrSlices {
+	return &chainTaggedTypePtrSlices{value: WindowTaggedTypePtr(c.value, size, step), err: c.err}
+}
+
+func FlattenTaggedTypePtr(slices [][]*TaggedType) (res []*TaggedType) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]*TaggedType, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainTaggedTypePtrSlices) Flatten() *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: FlattenTaggedTypePtr(c.value), isPtr: true, err: c.err}
+}
+
+func FlatMapTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) []*TaggedType) (res []*TaggedType) {
+	mapped := make([][]*TaggedType, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]*TaggedType, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) FlatMap(fn func(*TaggedType, int) []*TaggedType) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: FlatMapTaggedTypePtr(c.value, fn), isPtr: true, err: c.err}
+}
+
+func MinByTaggedTypePtr(slice []*TaggedType, less func(a, b *TaggedType) bool) (res *TaggedType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainTaggedTypePtr) MinBy(less func(a, b *TaggedType) bool) (*TaggedType, bool) {
+	return MinByTaggedTypePtr(c.value, less)
+}
+
+func MaxByTaggedTypePtr(slice []*TaggedType, less func(a, b *TaggedType) bool) (res *TaggedType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainTaggedTypePtr) MaxBy(less func(a, b *TaggedType) bool) (*TaggedType, bool) {
+	return MaxByTaggedTypePtr(c.value, less)
+}
+
+func ShuffleTaggedTypePtr(slice []*TaggedType, rnd interface{ Intn(int) int }) (res []*TaggedType) {
+	res = make([]*TaggedType, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) Shuffle(rnd interface{ Intn(int) int }) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: ShuffleTaggedTypePtr(c.value, rnd), err: c.err}
+}
+
+func SampleTaggedTypePtr(slice []*TaggedType, rnd interface{ Intn(int) int }) (res *TaggedType, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainTaggedTypePtr) Sample(rnd interface{ Intn(int) int }) (*TaggedType, bool) {
+	return SampleTaggedTypePtr(c.value, rnd)
+}
+
+func SampleNTaggedTypePtr(slice []*TaggedType, n int, rnd interface{ Intn(int) int }) (res []*TaggedType) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]*TaggedType, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) SampleN(n int, rnd interface{ Intn(int) int }) *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: SampleNTaggedTypePtr(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceTaggedTypePtr(slice []*TaggedType, weight func(*TaggedType) float64, rnd interface{ Float64() float64 }) (res *TaggedType, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainTaggedTypePtr) WeightedChoice(weight func(*TaggedType) float64, rnd interface{ Float64() float64 }) (*TaggedType, bool) {
+	return WeightedChoiceTaggedTypePtr(c.value, weight, rnd)
+}
+
+// lazyTaggedTypePtrStep is a step of a lazyTaggedTypePtr. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyTaggedTypePtrStep struct {
+	start func() func(*TaggedType) (*TaggedType, bool, bool)
+	apply func([]*TaggedType) []*TaggedType
+}
+
+type lazyTaggedTypePtr struct {
+	isPtr  bool
+	err    error
+	source []*TaggedType
+	steps  []lazyTaggedTypePtrStep
+}
+
+func (c *chainTaggedTypePtr) Lazy() *lazyTaggedTypePtr {
+	return &lazyTaggedTypePtr{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyTaggedTypePtr) then(step lazyTaggedTypePtrStep) *lazyTaggedTypePtr {
+	// copied, so that chains can branch from c
+	steps := make([]lazyTaggedTypePtrStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyTaggedTypePtr{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyTaggedTypePtr) Filter(fn func(*TaggedType, int) bool) *lazyTaggedTypePtr {
+	return c.then(lazyTaggedTypePtrStep{start: func() func(*TaggedType) (*TaggedType, bool, bool) {
+		index := 0
+		return func(entry *TaggedType) (*TaggedType, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyTaggedTypePtr) Map(fn func(*TaggedType, int) *TaggedType) *lazyTaggedTypePtr {
+	return c.then(lazyTaggedTypePtrStep{start: func() func(*TaggedType) (*TaggedType, bool, bool) {
+		index := 0
+		return func(entry *TaggedType) (*TaggedType, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyTaggedTypePtr) Drop(n int) *lazyTaggedTypePtr {
+	return c.then(lazyTaggedTypePtrStep{start: func() func(*TaggedType) (*TaggedType, bool, bool) {
+		seen := 0
+		return func(entry *TaggedType) (*TaggedType, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyTaggedTypePtr) Take(n int) *lazyTaggedTypePtr {
+	return c.then(lazyTaggedTypePtrStep{start: func() func(*TaggedType) (*TaggedType, bool, bool) {
+		taken := 0
+		return func(entry *TaggedType) (*TaggedType, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyTaggedTypePtr) TakeWhile(fn func(*TaggedType, int) bool) *lazyTaggedTypePtr {
+	return c.then(lazyTaggedTypePtrStep{start: func() func(*TaggedType) (*TaggedType, bool, bool) {
+		index := 0
+		return func(entry *TaggedType) (*TaggedType, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyTaggedTypePtr) DropWhile(fn func(*TaggedType, int) bool) *lazyTaggedTypePtr {
+	return c.then(lazyTaggedTypePtrStep{start: func() func(*TaggedType) (*TaggedType, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry *TaggedType) (*TaggedType, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyTaggedTypePtr) Reverse() *lazyTaggedTypePtr {
+	return c.then(lazyTaggedTypePtrStep{apply: func(slice []*TaggedType) []*TaggedType {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyTaggedTypePtr) DropRight(n int) *lazyTaggedTypePtr {
+	return c.then(lazyTaggedTypePtrStep{apply: func(slice []*TaggedType) []*TaggedType {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyTaggedTypePtr) TakeRight(n int) *lazyTaggedTypePtr {
+	return c.then(lazyTaggedTypePtrStep{apply: func(slice []*TaggedType) []*TaggedType {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyTaggedTypePtr) Value() []*TaggedType {
+	res := make([]*TaggedType, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(*TaggedType) (*TaggedType, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyTaggedTypePtr) Eager() *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelTaggedTypePtr calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelTaggedTypePtr(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int), workers int) {
+	runParallelTaggedTypePtr(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelTaggedTypePtr struct {
+	isPtr   bool
+	err     error
+	value   []*TaggedType
+	workers int
+}
+
+func (c *chainTaggedTypePtr) Parallel(workers int) *parallelTaggedTypePtr {
+	return &parallelTaggedTypePtr{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelTaggedTypePtr) ForEach(fn func(*TaggedType, int)) {
+	ParallelForEachTaggedTypePtr(c.value, fn, c.workers)
+}
+
+func (c *parallelTaggedTypePtr) Value() []*TaggedType {
+	return c.value
+}
+
+func (c *parallelTaggedTypePtr) Result() ([]*TaggedType, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelTaggedTypePtr) Sequential() *chainTaggedTypePtr {
+	return &chainTaggedTypePtr{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) *TaggedType, workers int) (res []*TaggedType) {
+	res = make([]*TaggedType, len(slice))
+	runParallelTaggedTypePtr(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelTaggedTypePtr) Map(fn func(*TaggedType, int) *TaggedType) *parallelTaggedTypePtr {
+	return &parallelTaggedTypePtr{value: ParallelMapTaggedTypePtr(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) bool, workers int) (res []*TaggedType) {
+	keep := make([]bool, len(slice))
+	runParallelTaggedTypePtr(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]*TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelTaggedTypePtr) Filter(fn func(*TaggedType, int) bool) *parallelTaggedTypePtr {
+	return &parallelTaggedTypePtr{value: ParallelFilterTaggedTypePtr(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) (*TaggedType, error)) (res []*TaggedType, err error) {
+	res = make([]*TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		var val *TaggedType
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) MapErr(fn func(*TaggedType, int) (*TaggedType, error)) *chainTaggedTypePtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrTaggedTypePtr(c.value, fn)
+	return &chainTaggedTypePtr{value: res, err: err}
+}
+
+func FilterErrTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) (bool, error)) (res []*TaggedType, err error) {
+	res = make([]*TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainTaggedTypePtr) FilterErr(fn func(*TaggedType, int) (bool, error)) *chainTaggedTypePtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrTaggedTypePtr(c.value, fn)
+	return &chainTaggedTypePtr{value: res, err: err}
+}
+
+func ReduceErrTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, *TaggedType, int) (*TaggedType, error), initial *TaggedType) (res *TaggedType, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainTaggedTypePtr) ReduceErr(fn func(*TaggedType, *TaggedType, int) (*TaggedType, error), initial *TaggedType) *chainTaggedTypePtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrTaggedTypePtr(c.value, fn, initial)
+	if err != nil {
+		return &chainTaggedTypePtr{err: err}
+	}
+	return &chainTaggedTypePtr{value: []*TaggedType{res}}
+}
+
+func ForEachErrTaggedTypePtr(slice []*TaggedType, fn func(*TaggedType, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainTaggedTypePtr) ForEachErr(fn func(*TaggedType, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrTaggedTypePtr(c.value, fn)
+}
+
+func MapCtxTaggedTypePtr(ctx context.Context, slice []*TaggedType, fn func(*TaggedType, int) (*TaggedType, error)) ([]*TaggedType, error) {
+	return MapErrTaggedTypePtr(slice, func(entry *TaggedType, index int) (*TaggedType, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainTaggedTypePtr) MapCtx(ctx context.Context, fn func(*TaggedType, int) (*TaggedType, error)) *chainTaggedTypePtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxTaggedTypePtr(ctx, c.value, fn)
+	return &chainTaggedTypePtr{value: res, err: err}
+}
+
+func FilterCtxTaggedTypePtr(ctx context.Context, slice []*TaggedType, fn func(*TaggedType, int) (bool, error)) ([]*TaggedType, error) {
+	return FilterErrTaggedTypePtr(slice, func(entry *TaggedType, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainTaggedTypePtr) FilterCtx(ctx context.Context, fn func(*TaggedType, int) (bool, error)) *chainTaggedTypePtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxTaggedTypePtr(ctx, c.value, fn)
+	return &chainTaggedTypePtr{value: res, err: err}
+}
+
+func ReduceCtxTaggedTypePtr(ctx context.Context, slice []*TaggedType, fn func(*TaggedType, *TaggedType, int) (*TaggedType, error), initial *TaggedType) (*TaggedType, error) {
+	return ReduceErrTaggedTypePtr(slice, func(acc *TaggedType, entry *TaggedType, index int) (*TaggedType, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainTaggedTypePtr) ReduceCtx(ctx context.Context, fn func(*TaggedType, *TaggedType, int) (*TaggedType, error), initial *TaggedType) *chainTaggedTypePtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxTaggedTypePtr(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainTaggedTypePtr{err: err}
+	}
+	return &chainTaggedTypePtr{value: []*TaggedType{res}}
+}
+
+func ForEachCtxTaggedTypePtr(ctx context.Context, slice []*TaggedType, fn func(*TaggedType, int) error) error {
+	return ForEachErrTaggedTypePtr(slice, func(entry *TaggedType, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainTaggedTypePtr) ForEachCtx(ctx context.Context, fn func(*TaggedType, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxTaggedTypePtr(ctx, c.value, fn)
+}
+
+func ParallelMapCtxTaggedTypePtr(ctx context.Context, slice []*TaggedType, fn func(*TaggedType, int) (*TaggedType, error), workers int) ([]*TaggedType, error) {
+	res := make([]*TaggedType, len(slice))
+	err := runParallelTaggedTypePtr(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelTaggedTypePtr) MapCtx(ctx context.Context, fn func(*TaggedType, int) (*TaggedType, error)) *parallelTaggedTypePtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxTaggedTypePtr(ctx, c.value, fn, c.workers)
+	return &parallelTaggedTypePtr{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxTaggedTypePtr(ctx context.Context, slice []*TaggedType, fn func(*TaggedType, int) (bool, error), workers int) ([]*TaggedType, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelTaggedTypePtr(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*TaggedType, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelTaggedTypePtr) FilterCtx(ctx context.Context, fn func(*TaggedType, int) (bool, error)) *parallelTaggedTypePtr {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxTaggedTypePtr(ctx, c.value, fn, c.workers)
+	return &parallelTaggedTypePtr{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxTaggedTypePtr(ctx context.Context, slice []*TaggedType, fn func(*TaggedType, int) error, workers int) error {
+	return runParallelTaggedTypePtr(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelTaggedTypePtr) ForEachCtx(ctx context.Context, fn func(*TaggedType, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxTaggedTypePtr(ctx, c.value, fn, c.workers)
+}
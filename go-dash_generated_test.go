@@ -1,19 +1,24 @@
 // This code is generated by https://github.com/jtyers/slice
 // DO NOT EDIT!
 
-
-
 package main
 
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
 type chainString struct {
-  isPtr bool
+	isPtr bool
 	value []string
+	err   error
 }
 
 func NewStringSlice(slice []string) *chainString {
 	return &chainString{
 		value: slice,
-		
 	}
 }
 
@@ -21,8 +26,18 @@ func (c *chainString) Value() []string {
 	return c.value
 }
 
+// Result returns the value, or the first error returned by a callback given
+// to an Err method earlier in the chain. Once a callback fails, the rest of
+// the chain runs on an empty slice.
+func (c *chainString) Result() ([]string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
 func ConcatString(slice []string, slice2 []string) (res []string) {
-	res = make([]string, 0, len(slice) + len(slice2))
+	res = make([]string, 0, len(slice)+len(slice2))
 	for _, entry := range slice {
 		res = append(res, entry)
 	}
@@ -33,7 +48,7 @@ func ConcatString(slice []string, slice2 []string) (res []string) {
 }
 
 func (c *chainString) Concat(slice2 []string) *chainString {
-	return &chainString{value: ConcatString(c.value, slice2)}
+	return &chainString{value: ConcatString(c.value, slice2), err: c.err}
 }
 
 func ContainsString(slice []string, item string) (res bool) {
@@ -41,7 +56,7 @@ func ContainsString(slice []string, item string) (res bool) {
 		if val == item {
 			return true
 		}
-		
+
 	}
 	return false
 }
@@ -55,15 +70,18 @@ func DropString(slice []string, n int) (res []string) {
 	if l < 0 {
 		l = 0
 	}
+	if l > len(slice) {
+		l = len(slice)
+	}
 	res = make([]string, 0, l)
-	for _, entry := range slice[len(slice) - l:] {
+	for _, entry := range slice[len(slice)-l:] {
 		res = append(res, entry)
 	}
 	return
 }
 
 func (c *chainString) Drop(n int) *chainString {
-	return &chainString{value: DropString(c.value, n)}
+	return &chainString{value: DropString(c.value, n), err: c.err}
 }
 
 func DropRightString(slice []string, n int) (res []string) {
@@ -71,6 +89,9 @@ func DropRightString(slice []string, n int) (res []string) {
 	if l < 0 {
 		l = 0
 	}
+	if l > len(slice) {
+		l = len(slice)
+	}
 	res = make([]string, 0, l)
 	for _, entry := range slice[:l] {
 		res = append(res, entry)
@@ -79,10 +100,10 @@ func DropRightString(slice []string, n int) (res []string) {
 }
 
 func (c *chainString) DropRight(n int) *chainString {
-	return &chainString{value: DropRightString(c.value, n)}
+	return &chainString{value: DropRightString(c.value, n), err: c.err}
 }
 
-func FilterString(slice []string, fn func(string,int)bool) (res []string) {
+func FilterString(slice []string, fn func(string, int) bool) (res []string) {
 	res = make([]string, 0, len(slice))
 	for index, entry := range slice {
 		if fn(entry, index) {
@@ -92,8 +113,8 @@ func FilterString(slice []string, fn func(string,int)bool) (res []string) {
 	return
 }
 
-func (c *chainString) Filter(fn func(string,int)bool) *chainString {
-	return &chainString{value: FilterString(c.value, fn)}
+func (c *chainString) Filter(fn func(string, int) bool) *chainString {
+	return &chainString{value: FilterString(c.value, fn), err: c.err}
 }
 
 func FirstString(slice []string) (res string) {
@@ -105,22 +126,22 @@ func FirstString(slice []string) (res string) {
 }
 
 func (c *chainString) First() *chainString {
-	return &chainString{value: []string{FirstString(c.value)}}
+	return &chainString{value: []string{FirstString(c.value)}, err: c.err}
 }
 
 func LastString(slice []string) (res string) {
 	if len(slice) == 0 {
 		return
 	}
-	res = slice[len(slice) - 1]
+	res = slice[len(slice)-1]
 	return
 }
 
 func (c *chainString) Last() *chainString {
-	return &chainString{value: []string{LastString(c.value)}}
+	return &chainString{value: []string{LastString(c.value)}, err: c.err}
 }
 
-func MapString(slice []string, fn func(string,int)string) (res []string) {
+func MapString(slice []string, fn func(string, int) string) (res []string) {
 	res = make([]string, 0, len(slice))
 	for index, entry := range slice {
 		res = append(res, fn(entry, index))
@@ -128,12 +149,11 @@ func MapString(slice []string, fn func(string,int)string) (res []string) {
 	return
 }
 
-func (c *chainString) Map(fn func(string,int)string) *chainString {
-	return &chainString{value: MapString(c.value, fn)}
+func (c *chainString) Map(fn func(string, int) string) *chainString {
+	return &chainString{value: MapString(c.value, fn), err: c.err}
 }
 
-
-func ReduceString(slice []string, fn func(string,string,int)string, initial string) (res string) {
+func ReduceString(slice []string, fn func(string, string, int) string, initial string) (res string) {
 	res = initial
 	for index, entry := range slice {
 		res = fn(res, entry, index)
@@ -141,8 +161,8 @@ func ReduceString(slice []string, fn func(string,string,int)string, initial stri
 	return
 }
 
-func (c *chainString) Reduce(fn func(string,string,int)string, initial string) *chainString {
-	return &chainString{value: []string{ReduceString(c.value, fn, initial)}}
+func (c *chainString) Reduce(fn func(string, string, int) string, initial string) *chainString {
+	return &chainString{value: []string{ReduceString(c.value, fn, initial)}, err: c.err}
 }
 
 func ReverseString(slice []string) (res []string) {
@@ -154,7 +174,7 @@ func ReverseString(slice []string) (res []string) {
 }
 
 func (c *chainString) Reverse() *chainString {
-	return &chainString{value: ReverseString(c.value)}
+	return &chainString{value: ReverseString(c.value), err: c.err}
 }
 
 func UniqString(slice []string) (res []string) {
@@ -167,11 +187,1418 @@ func UniqString(slice []string) (res []string) {
 		}
 	}
 	return
+
 }
 
 func (c *chainString) Uniq() *chainString {
 	if c.isPtr {
 		panic("Uniq() does not currently support pointers")
 	}
-	return &chainString{value: UniqString(c.value)}
+	return &chainString{value: UniqString(c.value), err: c.err}
+}
+
+func MapStringToInt(slice []string, fn func(string, int) int) (res []int) {
+	res = make([]int, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainString) MapToInt(fn func(string, int) int) *chainInt {
+	return &chainInt{value: MapStringToInt(c.value, fn), err: c.err}
+}
+
+func MapStringToStringPtr(slice []string, fn func(string, int) *string) (res []*string) {
+	res = make([]*string, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+}
+
+func (c *chainString) MapToStringPtr(fn func(string, int) *string) *chainStringPtr {
+	return &chainStringPtr{value: MapStringToStringPtr(c.value, fn), isPtr: true, err: c.err}
+}
+
+func ReduceStringToInt(slice []string, fn func(int, string, int) int, initial int) (res int) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainString) ReduceToInt(fn func(int, string, int) int, initial int) *chainInt {
+	return &chainInt{value: []int{ReduceStringToInt(c.value, fn, initial)}, err: c.err}
+}
+
+func ReduceStringToStringPtr(slice []string, fn func(*string, string, int) *string, initial *string) (res *string) {
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+}
+
+func (c *chainString) ReduceToStringPtr(fn func(*string, string, int) *string, initial *string) *chainStringPtr {
+	return &chainStringPtr{value: []*string{ReduceStringToStringPtr(c.value, fn, initial)}, isPtr: true, err: c.err}
+}
+
+func SortString(slice []string) (res []string) {
+	res = make([]string, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainString) Sort() *chainString {
+	return &chainString{value: SortString(c.value), err: c.err}
+}
+
+func SortStableString(slice []string) (res []string) {
+	res = make([]string, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return
+}
+
+func (c *chainString) SortStable() *chainString {
+	return &chainString{value: SortStableString(c.value), err: c.err}
+}
+
+func IsSortedString(slice []string) bool {
+	for index := 1; index < len(slice); index++ {
+		if slice[index] < slice[index-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainString) IsSorted() bool {
+	return IsSortedString(c.value)
+}
+
+func SortByString(slice []string, less func(a, b string) bool) (res []string) {
+	res = make([]string, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainString) SortBy(less func(a, b string) bool) *chainString {
+	return &chainString{value: SortByString(c.value, less), err: c.err}
+}
+
+func SortStableByString(slice []string, less func(a, b string) bool) (res []string) {
+	res = make([]string, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chainString) SortStableBy(less func(a, b string) bool) *chainString {
+	return &chainString{value: SortStableByString(c.value, less), err: c.err}
+}
+
+func IsSortedByString(slice []string, less func(a, b string) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainString) IsSortedBy(less func(a, b string) bool) bool {
+	return IsSortedByString(c.value, less)
+}
+
+func SortStringByKeyInt(slice []string, key func(string) int) (res []string) {
+	keys := make([]int, len(slice))
+	order := make([]int, len(slice))
+	for index, entry := range slice {
+		keys[index] = key(entry)
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return keys[order[i]] < keys[order[j]]
+	})
+	res = make([]string, len(slice))
+	for index, from := range order {
+		res[index] = slice[from]
+	}
+	return
+}
+
+func (c *chainString) SortByKeyInt(key func(string) int) *chainString {
+	return &chainString{value: SortStringByKeyInt(c.value, key), err: c.err}
+}
+
+func UnionString(slice []string, slice2 []string) (res []string) {
+	res = []string{}
+	seen := make(map[string]bool)
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainString) Union(slice2 []string) *chainString {
+	return &chainString{value: UnionString(c.value, slice2), err: c.err}
+}
+
+func IntersectionString(slice []string, slice2 []string) (res []string) {
+	res = []string{}
+	in := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	seen := make(map[string]bool)
+	for _, entry := range slice {
+		if in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainString) Intersection(slice2 []string) *chainString {
+	return &chainString{value: IntersectionString(c.value, slice2), err: c.err}
+}
+
+func DifferenceString(slice []string, slice2 []string) (res []string) {
+	res = []string{}
+	seen := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[entry] = true
+	}
+	for _, entry := range slice {
+		if !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainString) Difference(slice2 []string) *chainString {
+	return &chainString{value: DifferenceString(c.value, slice2), err: c.err}
+}
+
+func XorString(slice []string, slice2 []string) (res []string) {
+	res = []string{}
+	in := make(map[string]bool, len(slice))
+	for _, entry := range slice {
+		in[entry] = true
+	}
+	in2 := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[entry] = true
+	}
+	seen := make(map[string]bool)
+	for _, entry := range slice {
+		if !in2[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[entry] && !seen[entry] {
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainString) Xor(slice2 []string) *chainString {
+	return &chainString{value: XorString(c.value, slice2), err: c.err}
+}
+
+func IsSubsetString(slice []string, slice2 []string) bool {
+	in := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if !in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainString) IsSubset(slice2 []string) bool {
+	return IsSubsetString(c.value, slice2)
+}
+
+func IsDisjointString(slice []string, slice2 []string) bool {
+	in := make(map[string]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[entry] = true
+	}
+	for _, entry := range slice {
+		if in[entry] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainString) IsDisjoint(slice2 []string) bool {
+	return IsDisjointString(c.value, slice2)
+}
+
+func GroupStringByInt(slice []string, fn func(string) int) (res map[int][]string) {
+	res = make(map[int][]string)
+	for _, entry := range slice {
+		key := fn(entry)
+		res[key] = append(res[key], entry)
+	}
+	return
+}
+
+func (c *chainString) GroupByInt(fn func(string) int) map[int][]string {
+	return GroupStringByInt(c.value, fn)
+}
+
+func GroupStringByBool(slice []string, fn func(string) bool) (res map[bool][]string) {
+	res = make(map[bool][]string)
+	for _, entry := range slice {
+		key := fn(entry)
+		res[key] = append(res[key], entry)
+	}
+	return
+}
+
+func (c *chainString) GroupByBool(fn func(string) bool) map[bool][]string {
+	return GroupStringByBool(c.value, fn)
+}
+
+func KeyStringByInt(slice []string, fn func(string) int) (res map[int]string) {
+	res = make(map[int]string, len(slice))
+	for _, entry := range slice {
+		res[fn(entry)] = entry
+	}
+	return
+}
+
+func (c *chainString) KeyByInt(fn func(string) int) map[int]string {
+	return KeyStringByInt(c.value, fn)
+}
+
+func KeyStringByBool(slice []string, fn func(string) bool) (res map[bool]string) {
+	res = make(map[bool]string, len(slice))
+	for _, entry := range slice {
+		res[fn(entry)] = entry
+	}
+	return
+}
+
+func (c *chainString) KeyByBool(fn func(string) bool) map[bool]string {
+	return KeyStringByBool(c.value, fn)
+}
+
+func CountStringByInt(slice []string, fn func(string) int) (res map[int]int) {
+	res = make(map[int]int)
+	for _, entry := range slice {
+		res[fn(entry)]++
+	}
+	return
+}
+
+func (c *chainString) CountByInt(fn func(string) int) map[int]int {
+	return CountStringByInt(c.value, fn)
+}
+
+func CountStringByBool(slice []string, fn func(string) bool) (res map[bool]int) {
+	res = make(map[bool]int)
+	for _, entry := range slice {
+		res[fn(entry)]++
+	}
+	return
+}
+
+func (c *chainString) CountByBool(fn func(string) bool) map[bool]int {
+	return CountStringByBool(c.value, fn)
+}
+
+func PartitionString(slice []string, fn func(string, int) bool) (yes []string, no []string) {
+	yes = []string{}
+	no = []string{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chainString) Partition(fn func(string, int) bool) ([]string, []string) {
+	return PartitionString(c.value, fn)
+}
+
+func FindString(slice []string, fn func(string, int) bool) (res string, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chainString) Find(fn func(string, int) bool) (string, bool) {
+	return FindString(c.value, fn)
+}
+
+func FindIndexString(slice []string, fn func(string, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainString) FindIndex(fn func(string, int) bool) int {
+	return FindIndexString(c.value, fn)
+}
+
+func FindLastString(slice []string, fn func(string, int) bool) (res string, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chainString) FindLast(fn func(string, int) bool) (string, bool) {
+	return FindLastString(c.value, fn)
+}
+
+func FindLastIndexString(slice []string, fn func(string, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainString) FindLastIndex(fn func(string, int) bool) int {
+	return FindLastIndexString(c.value, fn)
+}
+
+func IndexOfString(slice []string, item string) int {
+	for index, val := range slice {
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainString) IndexOf(item string) int {
+	return IndexOfString(c.value, item)
+}
+
+func LastIndexOfString(slice []string, item string) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if val == item {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chainString) LastIndexOf(item string) int {
+	return LastIndexOfString(c.value, item)
+}
+
+func EveryString(slice []string, fn func(string, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainString) Every(fn func(string, int) bool) bool {
+	return EveryString(c.value, fn)
+}
+
+func SomeString(slice []string, fn func(string, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chainString) Some(fn func(string, int) bool) bool {
+	return SomeString(c.value, fn)
+}
+
+func NoneString(slice []string, fn func(string, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainString) None(fn func(string, int) bool) bool {
+	return NoneString(c.value, fn)
+}
+
+func CountString(slice []string, fn func(string, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chainString) Count(fn func(string, int) bool) int {
+	return CountString(c.value, fn)
+}
+
+func TakeString(slice []string, n int) (res []string) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]string, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainString) Take(n int) *chainString {
+	return &chainString{value: TakeString(c.value, n), err: c.err}
+}
+
+func TakeRightString(slice []string, n int) (res []string) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]string, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chainString) TakeRight(n int) *chainString {
+	return &chainString{value: TakeRightString(c.value, n), err: c.err}
+}
+
+func TakeWhileString(slice []string, fn func(string, int) bool) (res []string) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]string, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainString) TakeWhile(fn func(string, int) bool) *chainString {
+	return &chainString{value: TakeWhileString(c.value, fn), err: c.err}
+}
+
+func TakeRightWhileString(slice []string, fn func(string, int) bool) (res []string) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]string, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainString) TakeRightWhile(fn func(string, int) bool) *chainString {
+	return &chainString{value: TakeRightWhileString(c.value, fn), err: c.err}
+}
+
+func DropWhileString(slice []string, fn func(string, int) bool) (res []string) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]string, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chainString) DropWhile(fn func(string, int) bool) *chainString {
+	return &chainString{value: DropWhileString(c.value, fn), err: c.err}
+}
+
+func DropRightWhileString(slice []string, fn func(string, int) bool) (res []string) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]string, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chainString) DropRightWhile(fn func(string, int) bool) *chainString {
+	return &chainString{value: DropRightWhileString(c.value, fn), err: c.err}
+}
+
+func SliceString(slice []string, start int, end int) (res []string) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]string, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chainString) Slice(start int, end int) *chainString {
+	return &chainString{value: SliceString(c.value, start, end), err: c.err}
+}
+
+type chainStringSlices struct {
+	value [][]string
+	err   error
+}
+
+func NewStringSlices(slices [][]string) *chainStringSlices {
+	return &chainStringSlices{value: slices}
+}
+
+func (c *chainStringSlices) Value() [][]string {
+	return c.value
+}
+
+func (c *chainStringSlices) Result() ([][]string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainStringSlices) Each(fn func([]string, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chainStringSlices) Map(fn func([]string, int) []string) *chainStringSlices {
+	res := make([][]string, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chainStringSlices{value: res, err: c.err}
+}
+
+func (c *chainStringSlices) Reduce(fn func(string, string, int) string, initial string) *chainString {
+	res := make([]string, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chainString{value: res, err: c.err}
+}
+
+func ChunkString(slice []string, size int) (res [][]string) {
+	res = [][]string{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]string, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chainString) Chunk(size int) *chainStringSlices {
+	return &chainStringSlices{value: ChunkString(c.value, size), err: c.err}
+}
+
+func WindowString(slice []string, size int, step int) (res [][]string) {
+	res = [][]string{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]string, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chainString) Window(size int, step int) *chainStringSlices {
+	return &chainStringSlices{value: WindowString(c.value, size, step), err: c.err}
+}
+
+func FlattenString(slices [][]string) (res []string) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]string, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chainStringSlices) Flatten() *chainString {
+	return &chainString{value: FlattenString(c.value), err: c.err}
+}
+
+func FlatMapString(slice []string, fn func(string, int) []string) (res []string) {
+	mapped := make([][]string, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]string, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chainString) FlatMap(fn func(string, int) []string) *chainString {
+	return &chainString{value: FlatMapString(c.value, fn), err: c.err}
+}
+
+func MinString(slice []string) (res string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if entry < res {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainString) Min() (string, bool) {
+	return MinString(c.value)
+}
+
+func MaxString(slice []string) (res string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if res < entry {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainString) Max() (string, bool) {
+	return MaxString(c.value)
+}
+
+func MinByString(slice []string, less func(a, b string) bool) (res string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainString) MinBy(less func(a, b string) bool) (string, bool) {
+	return MinByString(c.value, less)
+}
+
+func MaxByString(slice []string, less func(a, b string) bool) (res string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chainString) MaxBy(less func(a, b string) bool) (string, bool) {
+	return MaxByString(c.value, less)
+}
+
+func ShuffleString(slice []string, rnd interface{ Intn(int) int }) (res []string) {
+	res = make([]string, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chainString) Shuffle(rnd interface{ Intn(int) int }) *chainString {
+	return &chainString{value: ShuffleString(c.value, rnd), err: c.err}
+}
+
+func SampleString(slice []string, rnd interface{ Intn(int) int }) (res string, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chainString) Sample(rnd interface{ Intn(int) int }) (string, bool) {
+	return SampleString(c.value, rnd)
+}
+
+func SampleNString(slice []string, n int, rnd interface{ Intn(int) int }) (res []string) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]string, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chainString) SampleN(n int, rnd interface{ Intn(int) int }) *chainString {
+	return &chainString{value: SampleNString(c.value, n, rnd), err: c.err}
+}
+
+func WeightedChoiceString(slice []string, weight func(string) float64, rnd interface{ Float64() float64 }) (res string, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chainString) WeightedChoice(weight func(string) float64, rnd interface{ Float64() float64 }) (string, bool) {
+	return WeightedChoiceString(c.value, weight, rnd)
+}
+
+// lazyStringStep is a step of a lazyString. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazyStringStep struct {
+	start func() func(string) (string, bool, bool)
+	apply func([]string) []string
+}
+
+type lazyString struct {
+	isPtr  bool
+	err    error
+	source []string
+	steps  []lazyStringStep
+}
+
+func (c *chainString) Lazy() *lazyString {
+	return &lazyString{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazyString) then(step lazyStringStep) *lazyString {
+	// copied, so that chains can branch from c
+	steps := make([]lazyStringStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazyString{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazyString) Filter(fn func(string, int) bool) *lazyString {
+	return c.then(lazyStringStep{start: func() func(string) (string, bool, bool) {
+		index := 0
+		return func(entry string) (string, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazyString) Map(fn func(string, int) string) *lazyString {
+	return c.then(lazyStringStep{start: func() func(string) (string, bool, bool) {
+		index := 0
+		return func(entry string) (string, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyString) Drop(n int) *lazyString {
+	return c.then(lazyStringStep{start: func() func(string) (string, bool, bool) {
+		seen := 0
+		return func(entry string) (string, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazyString) Take(n int) *lazyString {
+	return c.then(lazyStringStep{start: func() func(string) (string, bool, bool) {
+		taken := 0
+		return func(entry string) (string, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazyString) TakeWhile(fn func(string, int) bool) *lazyString {
+	return c.then(lazyStringStep{start: func() func(string) (string, bool, bool) {
+		index := 0
+		return func(entry string) (string, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyString) DropWhile(fn func(string, int) bool) *lazyString {
+	return c.then(lazyStringStep{start: func() func(string) (string, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry string) (string, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazyString) Reverse() *lazyString {
+	return c.then(lazyStringStep{apply: func(slice []string) []string {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazyString) DropRight(n int) *lazyString {
+	return c.then(lazyStringStep{apply: func(slice []string) []string {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazyString) TakeRight(n int) *lazyString {
+	return c.then(lazyStringStep{apply: func(slice []string) []string {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazyString) Value() []string {
+	res := make([]string, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func(string) (string, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazyString) Eager() *chainString {
+	return &chainString{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+// runParallelString calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallelString(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEachString(slice []string, fn func(string, int), workers int) {
+	runParallelString(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallelString struct {
+	isPtr   bool
+	err     error
+	value   []string
+	workers int
+}
+
+func (c *chainString) Parallel(workers int) *parallelString {
+	return &parallelString{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallelString) ForEach(fn func(string, int)) {
+	ParallelForEachString(c.value, fn, c.workers)
+}
+
+func (c *parallelString) Value() []string {
+	return c.value
+}
+
+func (c *parallelString) Result() ([]string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallelString) Sequential() *chainString {
+	return &chainString{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func ParallelMapString(slice []string, fn func(string, int) string, workers int) (res []string) {
+	res = make([]string, len(slice))
+	runParallelString(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallelString) Map(fn func(string, int) string) *parallelString {
+	return &parallelString{value: ParallelMapString(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func ParallelFilterString(slice []string, fn func(string, int) bool, workers int) (res []string) {
+	keep := make([]bool, len(slice))
+	runParallelString(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]string, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallelString) Filter(fn func(string, int) bool) *parallelString {
+	return &parallelString{value: ParallelFilterString(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+func MapErrString(slice []string, fn func(string, int) (string, error)) (res []string, err error) {
+	res = make([]string, 0, len(slice))
+	for index, entry := range slice {
+		var val string
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chainString) MapErr(fn func(string, int) (string, error)) *chainString {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErrString(c.value, fn)
+	return &chainString{value: res, err: err}
+}
+
+func FilterErrString(slice []string, fn func(string, int) (bool, error)) (res []string, err error) {
+	res = make([]string, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chainString) FilterErr(fn func(string, int) (bool, error)) *chainString {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErrString(c.value, fn)
+	return &chainString{value: res, err: err}
+}
+
+func ReduceErrString(slice []string, fn func(string, string, int) (string, error), initial string) (res string, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chainString) ReduceErr(fn func(string, string, int) (string, error), initial string) *chainString {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErrString(c.value, fn, initial)
+	if err != nil {
+		return &chainString{err: err}
+	}
+	return &chainString{value: []string{res}}
+}
+
+func ForEachErrString(slice []string, fn func(string, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainString) ForEachErr(fn func(string, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErrString(c.value, fn)
+}
+
+func MapCtxString(ctx context.Context, slice []string, fn func(string, int) (string, error)) ([]string, error) {
+	return MapErrString(slice, func(entry string, index int) (string, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainString) MapCtx(ctx context.Context, fn func(string, int) (string, error)) *chainString {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtxString(ctx, c.value, fn)
+	return &chainString{value: res, err: err}
+}
+
+func FilterCtxString(ctx context.Context, slice []string, fn func(string, int) (bool, error)) ([]string, error) {
+	return FilterErrString(slice, func(entry string, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainString) FilterCtx(ctx context.Context, fn func(string, int) (bool, error)) *chainString {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtxString(ctx, c.value, fn)
+	return &chainString{value: res, err: err}
+}
+
+func ReduceCtxString(ctx context.Context, slice []string, fn func(string, string, int) (string, error), initial string) (string, error) {
+	return ReduceErrString(slice, func(acc string, entry string, index int) (string, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chainString) ReduceCtx(ctx context.Context, fn func(string, string, int) (string, error), initial string) *chainString {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtxString(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chainString{err: err}
+	}
+	return &chainString{value: []string{res}}
+}
+
+func ForEachCtxString(ctx context.Context, slice []string, fn func(string, int) error) error {
+	return ForEachErrString(slice, func(entry string, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chainString) ForEachCtx(ctx context.Context, fn func(string, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtxString(ctx, c.value, fn)
+}
+
+func ParallelMapCtxString(ctx context.Context, slice []string, fn func(string, int) (string, error), workers int) ([]string, error) {
+	res := make([]string, len(slice))
+	err := runParallelString(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallelString) MapCtx(ctx context.Context, fn func(string, int) (string, error)) *parallelString {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtxString(ctx, c.value, fn, c.workers)
+	return &parallelString{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelFilterCtxString(ctx context.Context, slice []string, fn func(string, int) (bool, error), workers int) ([]string, error) {
+	keep := make([]bool, len(slice))
+	err := runParallelString(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallelString) FilterCtx(ctx context.Context, fn func(string, int) (bool, error)) *parallelString {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtxString(ctx, c.value, fn, c.workers)
+	return &parallelString{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+func ParallelForEachCtxString(ctx context.Context, slice []string, fn func(string, int) error, workers int) error {
+	return runParallelString(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallelString) ForEachCtx(ctx context.Context, fn func(string, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtxString(ctx, c.value, fn, c.workers)
 }
@@ -0,0 +1,57 @@
+//go:build go1.18
+// +build go1.18
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func TestFloat64Generic(t *testing.T) {
+	c := NewFloat64Slice([]float64{1.5, 2, 3, 2, 4.5, 1.5})
+
+	got := c.Uniq().
+		Filter(func(f float64, i int) bool { return f > 1.5 }).
+		Map(func(f float64, i int) float64 { return f * 2 }).
+		Concat([]float64{10}).
+		Drop(1).
+		Reverse()
+
+	require.Equal(t, []float64{10, 9, 6}, got.Value())
+	require.Equal(t, []float64{23}, got.DropRight(1).Reduce(func(acc float64, f float64, i int) float64 { return acc + f }, 4).Value())
+	require.Equal(t, []float64{10}, got.First().Value())
+	require.Equal(t, []float64{6}, got.Last().Value())
+	require.True(t, got.Contains(9))
+	require.False(t, got.Contains(4))
+}
+
+func TestFloat64PtrGenericContains(t *testing.T) {
+	first := float64Ptr(1)
+	slice := []*float64{first, float64Ptr(2), float64Ptr(3)}
+
+	var tests = []struct {
+		name   string
+		test   *float64
+		output bool
+	}{
+		{"should return true for the same pointer", first, true},
+		{"should return true for a pointer to an equal value", float64Ptr(2), true},
+		{"should return false if the value is not present", float64Ptr(4), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewFloat64PtrSlice(slice)
+
+			got := c.Contains(test.test)
+
+			require.Equal(t, got, test.output)
+		})
+	}
+}
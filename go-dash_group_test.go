@@ -0,0 +1,98 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringGroupByInt(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	var tests = []struct {
+		name   string
+		input  []string
+		groups map[int][]string
+		keys   map[int]string
+		counts map[int]int
+	}{
+		{
+			"should group strings by length",
+			[]string{"a", "bb", "c", "dd", "eee"},
+			map[int][]string{1: {"a", "c"}, 2: {"bb", "dd"}, 3: {"eee"}},
+			map[int]string{1: "c", 2: "dd", 3: "eee"},
+			map[int]int{1: 2, 2: 2, 3: 1},
+		},
+		{
+			"should group an empty slice",
+			[]string{},
+			map[int][]string{},
+			map[int]string{},
+			map[int]int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.groups, GroupStringByInt(test.input, length))
+			require.Equal(t, test.keys, KeyStringByInt(test.input, length))
+			require.Equal(t, test.counts, CountStringByInt(test.input, length))
+
+			chain := NewStringSlice(test.input)
+			require.Equal(t, test.groups, chain.GroupByInt(length))
+			require.Equal(t, test.keys, chain.KeyByInt(length))
+			require.Equal(t, test.counts, chain.CountByInt(length))
+		})
+	}
+}
+
+func TestStringCountByBool(t *testing.T) {
+	empty := func(s string) bool { return s == "" }
+
+	require.Equal(t, map[bool]int{true: 2, false: 1}, CountStringByBool([]string{"", "a", ""}, empty))
+	require.Equal(t, map[bool][]string{false: {"a"}}, GroupStringByBool([]string{"a"}, empty))
+}
+
+func TestStringPartition(t *testing.T) {
+	var tests = []struct {
+		name  string
+		input []string
+		fn    func(string, int) bool
+		yes   []string
+		no    []string
+	}{
+		{
+			"should split by predicate, keeping order",
+			[]string{"a", "bb", "c", "dd"},
+			func(s string, i int) bool { return len(s) == 1 },
+			[]string{"a", "c"},
+			[]string{"bb", "dd"},
+		},
+		{
+			"should pass the index",
+			[]string{"a", "b", "c"},
+			func(s string, i int) bool { return i == 1 },
+			[]string{"b"},
+			[]string{"a", "c"},
+		},
+		{
+			"should split an empty slice",
+			[]string{},
+			func(s string, i int) bool { return true },
+			[]string{},
+			[]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			yes, no := PartitionString(test.input, test.fn)
+			require.Equal(t, test.yes, yes)
+			require.Equal(t, test.no, no)
+
+			yes, no = NewStringSlice(test.input).Partition(test.fn)
+			require.Equal(t, test.yes, yes)
+			require.Equal(t, test.no, no)
+		})
+	}
+}
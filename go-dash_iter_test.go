@@ -0,0 +1,75 @@
+//go:build go1.23
+// +build go1.23
+
+package main
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt64All(t *testing.T) {
+	res := map[int]int64{}
+	for index, entry := range NewInt64Slice([]int64{5, 6, 7}).All() {
+		res[index] = entry
+	}
+	require.Equal(t, map[int]int64{0: 5, 1: 6, 2: 7}, res)
+
+	// stops when the loop does
+	seen := []int{}
+	for index := range AllInt64([]int64{5, 6, 7}) {
+		seen = append(seen, index)
+		if index == 1 {
+			break
+		}
+	}
+	require.Equal(t, []int{0, 1}, seen)
+
+	require.Equal(t, map[int]int64{0: 5, 1: 6}, maps.Collect(AllInt64([]int64{5, 6})))
+}
+
+func TestInt64Values(t *testing.T) {
+	require.Equal(t, []int64{5, 6, 7}, slices.Collect(NewInt64Slice([]int64{5, 6, 7}).Values()))
+	require.Equal(t, []int64(nil), slices.Collect(ValuesInt64(nil)))
+	require.Equal(t, []int64{5, 6, 7}, slices.Sorted(ValuesInt64([]int64{7, 5, 6})))
+}
+
+func TestInt64FromSeq(t *testing.T) {
+	res := FromSeqInt64(slices.Values([]int64{3, 1, 2})).Reverse().Value()
+	require.Equal(t, []int64{2, 1, 3}, res)
+
+	require.Equal(t, []int64{}, FromSeqInt64(slices.Values([]int64{})).Value())
+}
+
+func TestInt64Seq(t *testing.T) {
+	calls := 0
+	odd := func(i int64, index int) bool {
+		calls++
+		return i%2 == 1
+	}
+	byIndex := func(i int64, index int) int64 { return i*10 + int64(index) }
+
+	seq := TakeSeqInt64(MapSeqInt64(FilterSeqInt64(ValuesInt64([]int64{1, 2, 3, 4, 5, 6, 7}), odd), byIndex), 2)
+	require.Equal(t, 0, calls)
+
+	// nothing past what is taken is looked at
+	require.Equal(t, []int64{10, 31}, slices.Collect(seq))
+	require.Equal(t, 3, calls)
+
+	// and each range starts afresh
+	require.Equal(t, []int64{10, 31}, slices.Collect(seq))
+
+	require.Empty(t, slices.Collect(TakeSeqInt64(ValuesInt64([]int64{1, 2}), 0)))
+	require.Equal(t, []int64{1, 2}, slices.Collect(TakeSeqInt64(ValuesInt64([]int64{1, 2}), 5)))
+}
+
+func TestInt64PtrFromSeq(t *testing.T) {
+	a, b := int64(1), int64(2)
+
+	chain := FromSeqInt64Ptr(slices.Values([]*int64{&a, &b}))
+	require.True(t, chain.isPtr)
+	require.Equal(t, []*int64{&a, &b}, slices.Collect(chain.Values()))
+}
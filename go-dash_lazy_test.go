@@ -0,0 +1,115 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntLazy(t *testing.T) {
+	even := func(i int, index int) bool { return i%2 == 0 }
+	double := func(i int, index int) int { return i * 2 }
+	byIndex := func(i int, index int) int { return i*10 + index }
+	small := func(i int, index int) bool { return i < 8 }
+
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	var tests = []struct {
+		name  string
+		lazy  func(*lazyInt) *lazyInt
+		eager func(*chainInt) *chainInt
+	}{
+		{
+			"should return the source unchanged",
+			func(c *lazyInt) *lazyInt { return c },
+			func(c *chainInt) *chainInt { return c },
+		},
+		{
+			"should filter and map",
+			func(c *lazyInt) *lazyInt { return c.Filter(even).Map(double) },
+			func(c *chainInt) *chainInt { return c.Filter(even).Map(double) },
+		},
+		{
+			"should pass indexes as each step sees them",
+			func(c *lazyInt) *lazyInt { return c.Filter(even).Map(byIndex).Drop(1).Map(byIndex) },
+			func(c *chainInt) *chainInt { return c.Filter(even).Map(byIndex).Drop(1).Map(byIndex) },
+		},
+		{
+			"should drop and take",
+			func(c *lazyInt) *lazyInt { return c.Drop(2).Take(5).Drop(1) },
+			func(c *chainInt) *chainInt { return c.Drop(2).Take(5).Drop(1) },
+		},
+		{
+			"should reverse between passes",
+			func(c *lazyInt) *lazyInt { return c.Map(double).Reverse().Take(3).Reverse().Filter(even) },
+			func(c *chainInt) *chainInt { return c.Map(double).Reverse().Take(3).Reverse().Filter(even) },
+		},
+		{
+			"should reverse first",
+			func(c *lazyInt) *lazyInt { return c.Reverse().Drop(1) },
+			func(c *chainInt) *chainInt { return c.Reverse().Drop(1) },
+		},
+		{
+			"should drop and take from the right",
+			func(c *lazyInt) *lazyInt { return c.DropRight(2).TakeRight(3).Map(double).DropRight(1) },
+			func(c *chainInt) *chainInt { return c.DropRight(2).TakeRight(3).Map(double).DropRight(1) },
+		},
+		{
+			"should clamp counts",
+			func(c *lazyInt) *lazyInt { return c.Drop(-1).Take(20).DropRight(-1).TakeRight(20).Take(-1) },
+			func(c *chainInt) *chainInt { return c.Drop(-1).Take(20).DropRight(-1).TakeRight(20).Take(-1) },
+		},
+		{
+			"should take and drop while",
+			func(c *lazyInt) *lazyInt { return c.DropWhile(even).TakeWhile(small).Reverse().DropWhile(small) },
+			func(c *chainInt) *chainInt { return c.DropWhile(even).TakeWhile(small).Reverse().DropWhile(small) },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := test.eager(NewIntSlice(input)).Value()
+			require.Equal(t, expected, test.lazy(NewIntSlice(input).Lazy()).Value())
+			require.Equal(t, expected, test.lazy(NewIntSlice(input).Lazy()).Eager().Value())
+			require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, input)
+		})
+	}
+}
+
+func TestIntLazyRunsOnValue(t *testing.T) {
+	calls := 0
+	count := func(i int, index int) int {
+		calls++
+		return i
+	}
+
+	lazy := NewIntSlice([]int{1, 2, 3, 4, 5}).Lazy().Map(count).Take(2)
+	require.Equal(t, 0, calls)
+
+	// nothing is done once Take has all it needs
+	require.Equal(t, []int{1, 2}, lazy.Value())
+	require.Equal(t, 2, calls)
+
+	// steps start afresh each time
+	require.Equal(t, []int{1, 2}, lazy.Value())
+	require.Equal(t, 4, calls)
+}
+
+func TestIntLazyBranches(t *testing.T) {
+	base := NewIntSlice([]int{1, 2, 3}).Lazy().Drop(1)
+
+	a := base.Reverse()
+	b := base.Take(1)
+
+	require.Equal(t, []int{3, 2}, a.Value())
+	require.Equal(t, []int{2}, b.Value())
+	require.Equal(t, []int{2, 3}, base.Value())
+}
+
+func TestStringPtrLazy(t *testing.T) {
+	input := stringPtrSlice([]string{"a", "b"})
+
+	chain := NewStringPtrSlice(input).Lazy().Reverse().Eager()
+	require.True(t, chain.isPtr)
+	require.Equal(t, []*string{input[1], input[0]}, chain.Value())
+}
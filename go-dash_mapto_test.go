@@ -0,0 +1,97 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringMapToInt(t *testing.T) {
+	var tests = []struct {
+		name    string
+		input   []string
+		mapFunc func(string, int) int
+		output  []int
+	}{
+		{
+			"should map strings to their lengths",
+			[]string{"a", "bb", "ccc"},
+			func(s string, i int) int {
+				return len(s)
+			},
+			[]int{1, 2, 3},
+		},
+		{
+			"should map an empty slice",
+			[]string{},
+			func(s string, i int) int {
+				return len(s)
+			},
+			[]int{},
+		},
+	}
+
+	for _, test := range tests {
+		c := NewStringSlice(test.input)
+
+		got := c.MapToInt(test.mapFunc)
+
+		require.Equal(t, got.Value(), test.output)
+		require.Equal(t, MapStringToInt(test.input, test.mapFunc), test.output)
+	}
+}
+
+func TestStringReduceToInt(t *testing.T) {
+	var tests = []struct {
+		name    string
+		input   []string
+		initial int
+		f       func(int, string, int) int
+		output  []int
+	}{
+		{
+			"should sum string lengths",
+			[]string{"a", "bb", "ccc"},
+			10,
+			func(acc int, s string, i int) int {
+				return acc + len(s)
+			},
+			[]int{16},
+		},
+	}
+
+	for _, test := range tests {
+		c := NewStringSlice(test.input)
+
+		got := c.ReduceToInt(test.f, test.initial)
+
+		require.Equal(t, got.Value(), test.output)
+	}
+}
+
+func TestStringMapToStringPtr(t *testing.T) {
+	c := NewStringSlice([]string{"first", "second"})
+
+	got := c.MapToStringPtr(func(s string, i int) *string {
+		return stringPtr(strings.ToUpper(s))
+	})
+
+	require.Equal(t, got.Value(), stringPtrSlice([]string{"FIRST", "SECOND"}))
+	require.True(t, got.isPtr)
+}
+
+func TestIntMapToStringChain(t *testing.T) {
+	got := NewIntSlice([]int{3, 1, 2, 3}).
+		Uniq().
+		MapToString(func(i int, index int) string {
+			return strconv.Itoa(i * 10)
+		}).
+		Reverse().
+		MapToInt(func(s string, index int) int {
+			return len(s) + index
+		})
+
+	require.Equal(t, got.Value(), []int{2, 3, 4})
+}
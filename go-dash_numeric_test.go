@@ -0,0 +1,177 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/jtyers/slice/customtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentsSumProduct(t *testing.T) {
+	var tests = []struct {
+		name    string
+		input   []Cents
+		sum     Cents
+		product Cents
+	}{
+		{"should add and multiply", []Cents{2, 3, 4}, 9, 24},
+		{"should handle negative numbers", []Cents{-2, 3}, 1, -6},
+		{"should return identities for an empty slice", []Cents{}, 0, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.sum, SumCents(test.input))
+			require.Equal(t, test.product, ProductCents(test.input))
+			require.Equal(t, test.sum, NewCentsSlice(test.input).Sum())
+			require.Equal(t, test.product, NewCentsSlice(test.input).Product())
+		})
+	}
+}
+
+func TestSumChecked(t *testing.T) {
+	var tests = []struct {
+		name  string
+		input []Cents
+		sum   Cents
+		ok    bool
+	}{
+		{"should add", []Cents{1, 2}, 3, true},
+		{"should detect overflow", []Cents{math.MaxInt64, 1}, 0, false},
+		{"should detect underflow", []Cents{math.MinInt64, -1}, 0, false},
+		{"should allow going back in range", []Cents{math.MaxInt64, -1, 1}, math.MaxInt64, true},
+		{"should add an empty slice", []Cents{}, 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sum, ok := SumCheckedCents(test.input)
+			require.Equal(t, test.sum, sum)
+			require.Equal(t, test.ok, ok)
+		})
+	}
+
+	sum, ok := SumCheckedUint8([]uint8{200, 55})
+	require.Equal(t, uint8(255), sum)
+	require.True(t, ok)
+
+	_, ok = NewUint8Slice([]uint8{200, 56}).SumChecked()
+	require.False(t, ok)
+}
+
+func TestCentsMinMax(t *testing.T) {
+	input := []Cents{3, -1, 4, 1}
+
+	min, ok := MinCents(input)
+	require.True(t, ok)
+	require.Equal(t, Cents(-1), min)
+
+	max, ok := NewCentsSlice(input).Max()
+	require.True(t, ok)
+	require.Equal(t, Cents(4), max)
+
+	_, ok = MinCents([]Cents{})
+	require.False(t, ok)
+	_, ok = MaxCents([]Cents{})
+	require.False(t, ok)
+}
+
+func TestMinMaxBy(t *testing.T) {
+	byLength := func(a, b string) bool { return len(a) < len(b) }
+	input := []string{"bb", "a", "ccc", "d"}
+
+	// ties go to the first
+	min, ok := MinByString(input, byLength)
+	require.True(t, ok)
+	require.Equal(t, "a", min)
+
+	max, ok := NewStringSlice(input).MaxBy(byLength)
+	require.True(t, ok)
+	require.Equal(t, "ccc", max)
+
+	_, ok = MaxByString([]string{}, byLength)
+	require.False(t, ok)
+
+	// Min and Max use the natural order of other types too
+	min, ok = MinString(input)
+	require.True(t, ok)
+	require.Equal(t, "a", min)
+
+	ptr, ok := MaxStringPtr(stringPtrSlice([]string{"a", "c", "b"}))
+	require.True(t, ok)
+	require.Equal(t, "c", *ptr)
+}
+
+func TestCentsStatistics(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    []Cents
+		mean     float64
+		median   float64
+		variance float64
+	}{
+		{"should find statistics", []Cents{2, 4, 4, 4, 5, 5, 7, 9}, 5, 4.5, 4},
+		{"should find the middle of an odd number of elements", []Cents{9, 1, 5}, 5, 5, 32.0 / 3},
+		{"should handle a single element", []Cents{3}, 3, 3, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mean, ok := MeanCents(test.input)
+			require.True(t, ok)
+			require.InDelta(t, test.mean, mean, 1e-9)
+
+			median, ok := MedianCents(test.input)
+			require.True(t, ok)
+			require.InDelta(t, test.median, median, 1e-9)
+
+			variance, ok := VarianceCents(test.input)
+			require.True(t, ok)
+			require.InDelta(t, test.variance, variance, 1e-9)
+
+			stdDev, ok := NewCentsSlice(test.input).StdDev()
+			require.True(t, ok)
+			require.InDelta(t, math.Sqrt(test.variance), stdDev, 1e-9)
+		})
+	}
+}
+
+func TestCentsStatisticsEmpty(t *testing.T) {
+	chain := NewCentsSlice([]Cents{})
+
+	_, ok := chain.Mean()
+	require.False(t, ok)
+	_, ok = chain.Median()
+	require.False(t, ok)
+	_, ok = chain.Percentile(50)
+	require.False(t, ok)
+	_, ok = chain.Variance()
+	require.False(t, ok)
+	_, ok = chain.StdDev()
+	require.False(t, ok)
+}
+
+func TestCentsPercentile(t *testing.T) {
+	input := []Cents{40, 10, 30, 20}
+
+	var tests = []struct {
+		p   float64
+		res float64
+		ok  bool
+	}{
+		{0, 10, true},
+		{100, 40, true},
+		{50, 25, true},
+		{25, 17.5, true},
+		{-1, 0, false},
+		{101, 0, false},
+		{math.NaN(), 0, false},
+	}
+
+	for _, test := range tests {
+		res, ok := PercentileCents(input, test.p)
+		require.Equal(t, test.ok, ok, "p=%v", test.p)
+		require.InDelta(t, test.res, res, 1e-9, "p=%v", test.p)
+	}
+}
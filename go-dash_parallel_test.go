@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntParallelMap(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	fn := func(i int, index int) int { return i*10 + index }
+
+	var tests = []struct {
+		name    string
+		input   []int
+		workers int
+	}{
+		{"should map with one worker", input, 1},
+		{"should map with several workers", input, 3},
+		{"should map with more workers than entries", input, 100},
+		{"should map with one worker if none are given", input, 0},
+		{"should map an empty slice", []int{}, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, MapInt(test.input, fn), ParallelMapInt(test.input, fn, test.workers))
+		})
+	}
+}
+
+func TestIntParallelFilter(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	fn := func(i int, index int) bool { return i%3 == 0 || index == 0 }
+
+	var tests = []struct {
+		name    string
+		input   []int
+		workers int
+	}{
+		{"should filter with one worker", input, 1},
+		{"should filter with several workers", input, 3},
+		{"should filter with more workers than entries", input, 100},
+		{"should filter an empty slice", []int{}, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, FilterInt(test.input, fn), ParallelFilterInt(test.input, fn, test.workers))
+		})
+	}
+}
+
+func TestIntParallelForEach(t *testing.T) {
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+
+	var sum, running, most int64
+	ParallelForEachInt(input, func(i int, index int) {
+		n := atomic.AddInt64(&running, 1)
+		for {
+			m := atomic.LoadInt64(&most)
+			if n <= m || atomic.CompareAndSwapInt64(&most, m, n) {
+				break
+			}
+		}
+		atomic.AddInt64(&sum, int64(i+index))
+		atomic.AddInt64(&running, -1)
+	}, 4)
+
+	require.Equal(t, int64(999*1000), sum)
+	require.LessOrEqual(t, most, int64(4))
+}
+
+func TestIntParallelPanics(t *testing.T) {
+	require.PanicsWithValue(t, "boom", func() {
+		ParallelMapInt([]int{1, 2, 3}, func(i int, index int) int {
+			if i == 2 {
+				panic("boom")
+			}
+			return i
+		}, 2)
+	})
+}
+
+func TestIntParallelChain(t *testing.T) {
+	res := NewIntSlice([]int{1, 2, 3, 4, 5}).
+		Parallel(2).
+		Map(func(i int, index int) int { return i * 2 }).
+		Filter(func(i int, index int) bool { return i > 4 }).
+		Sequential().
+		Reverse().
+		Value()
+
+	require.Equal(t, []int{10, 8, 6}, res)
+
+	var count int64
+	NewIntSlice([]int{1, 2, 3}).Parallel(3).ForEach(func(i int, index int) {
+		atomic.AddInt64(&count, int64(i))
+	})
+	require.Equal(t, int64(6), count)
+}
+
+func TestStringPtrParallel(t *testing.T) {
+	input := stringPtrSlice([]string{"a", "b"})
+
+	chain := NewStringPtrSlice(input).Parallel(2).Filter(func(s *string, index int) bool { return *s == "b" }).Sequential()
+	require.True(t, chain.isPtr)
+	require.Equal(t, []*string{input[1]}, chain.Value())
+}
+
+func TestIntParallelPanicsAfterError(t *testing.T) {
+	started, failed := make(chan struct{}), make(chan struct{})
+
+	require.PanicsWithValue(t, "boom", func() {
+		ParallelMapCtxInt(context.Background(), []int{0, 1}, func(i int, index int) (int, error) {
+			if i == 0 {
+				<-started
+				close(failed)
+				return 0, errTest
+			}
+			// let the error be recorded first
+			close(started)
+			<-failed
+			time.Sleep(10 * time.Millisecond)
+			panic("boom")
+		}, 2)
+	})
+}
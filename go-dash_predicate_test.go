@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntPredicates(t *testing.T) {
+	even := func(i int, index int) bool { return i%2 == 0 }
+
+	var tests = []struct {
+		name  string
+		input []int
+		every bool
+		some  bool
+		none  bool
+		count int
+	}{
+		{"should find every element matches", []int{2, 4, 6}, true, true, false, 3},
+		{"should find some elements match", []int{1, 2, 3, 4}, false, true, false, 2},
+		{"should find no elements match", []int{1, 3}, false, false, true, 0},
+		{"should treat an empty slice as vacuously true", []int{}, true, false, true, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.every, EveryInt(test.input, even))
+			require.Equal(t, test.some, SomeInt(test.input, even))
+			require.Equal(t, test.none, NoneInt(test.input, even))
+			require.Equal(t, test.count, CountInt(test.input, even))
+
+			chain := NewIntSlice(test.input)
+			require.Equal(t, test.every, chain.Every(even))
+			require.Equal(t, test.some, chain.Some(even))
+			require.Equal(t, test.none, chain.None(even))
+			require.Equal(t, test.count, chain.Count(even))
+		})
+	}
+}
+
+func TestIntPredicatesShortCircuit(t *testing.T) {
+	calls := 0
+	positive := func(i int, index int) bool {
+		calls++
+		return i > 0
+	}
+
+	input := []int{1, -1, 2, 3}
+
+	require.False(t, EveryInt(input, positive))
+	require.Equal(t, 2, calls)
+
+	calls = 0
+	require.True(t, SomeInt(input, positive))
+	require.Equal(t, 1, calls)
+
+	calls = 0
+	require.False(t, NoneInt(input, positive))
+	require.Equal(t, 1, calls)
+
+	calls = 0
+	require.Equal(t, 3, CountInt(input, positive))
+	require.Equal(t, 4, calls)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fixedSource returns the given values in turn.
+type fixedSource struct {
+	ints   []int
+	floats []float64
+}
+
+func (s *fixedSource) Intn(n int) (res int) {
+	res, s.ints = s.ints[0]%n, s.ints[1:]
+	return
+}
+
+func (s *fixedSource) Float64() (res float64) {
+	res, s.floats = s.floats[0], s.floats[1:]
+	return
+}
+
+func TestIntShuffle(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+
+	res := ShuffleInt(input, rand.New(rand.NewSource(1)))
+	require.Equal(t, []int{1, 2, 3, 4, 5}, input)
+
+	sorted := append([]int{}, res...)
+	sort.Ints(sorted)
+	require.Equal(t, input, sorted)
+
+	// the same seed gives the same order
+	require.Equal(t, res, NewIntSlice(input).Shuffle(rand.New(rand.NewSource(1))).Value())
+
+	// swaps index 3 with 1, 2 with 0, then 1 with itself
+	res = ShuffleInt([]int{1, 2, 3, 4}, &fixedSource{ints: []int{1, 0, 1}})
+	require.Equal(t, []int{3, 4, 1, 2}, res)
+
+	require.Equal(t, []int{}, ShuffleInt([]int{}, rand.New(rand.NewSource(1))))
+}
+
+func TestIntSample(t *testing.T) {
+	res, ok := SampleInt([]int{1, 2, 3}, &fixedSource{ints: []int{2}})
+	require.True(t, ok)
+	require.Equal(t, 3, res)
+
+	res, ok = NewIntSlice([]int{1, 2, 3}).Sample(&fixedSource{ints: []int{0}})
+	require.True(t, ok)
+	require.Equal(t, 1, res)
+
+	_, ok = SampleInt([]int{}, &fixedSource{})
+	require.False(t, ok)
+}
+
+func TestIntSampleN(t *testing.T) {
+	var tests = []struct {
+		name  string
+		input []int
+		n     int
+		len   int
+	}{
+		{"should sample some elements", []int{1, 2, 3, 4, 5, 6}, 3, 3},
+		{"should sample every element if n is too large", []int{1, 2, 3}, 5, 3},
+		{"should sample nothing if n is negative", []int{1, 2, 3}, -1, 0},
+		{"should sample nothing from an empty slice", []int{}, 2, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := SampleNInt(test.input, test.n, rand.New(rand.NewSource(1)))
+			require.Len(t, res, test.len)
+			require.Len(t, UniqInt(res), test.len)
+			require.True(t, IsSubsetInt(res, test.input))
+
+			require.Equal(t, res, NewIntSlice(test.input).SampleN(test.n, rand.New(rand.NewSource(1))).Value())
+		})
+	}
+
+	// replaces the first with 3, keeps 1, replaces the second with 5
+	res := SampleNInt([]int{1, 2, 3, 4, 5}, 2, &fixedSource{ints: []int{0, 3, 1}})
+	require.Equal(t, []int{3, 5}, res)
+}
+
+func TestStringWeightedChoice(t *testing.T) {
+	weights := map[string]float64{"a": 1, "b": 0, "c": 3, "d": -1}
+	weight := func(s string) float64 { return weights[s] }
+	input := []string{"a", "b", "c", "d"}
+
+	var tests = []struct {
+		roll   float64
+		output string
+	}{
+		{0, "a"},
+		{0.2, "a"},
+		{0.25, "c"},
+		{0.99, "c"},
+		{1, "c"},
+	}
+
+	for _, test := range tests {
+		res, ok := WeightedChoiceString(input, weight, &fixedSource{floats: []float64{test.roll}})
+		require.True(t, ok)
+		require.Equal(t, test.output, res, "roll %v", test.roll)
+	}
+
+	res, ok := NewStringSlice(input).WeightedChoice(weight, rand.New(rand.NewSource(1)))
+	require.True(t, ok)
+	require.Contains(t, []string{"a", "c"}, res)
+
+	_, ok = WeightedChoiceString([]string{"b", "d"}, weight, &fixedSource{floats: []float64{0.5}})
+	require.False(t, ok)
+
+	_, ok = WeightedChoiceString([]string{}, weight, &fixedSource{})
+	require.False(t, ok)
+}
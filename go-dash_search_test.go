@@ -0,0 +1,111 @@
+package main
+
+import (
+	"testing"
+
+	. "github.com/jtyers/slice/customtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringFind(t *testing.T) {
+	long := func(s string, i int) bool { return len(s) > 1 }
+
+	var tests = []struct {
+		name      string
+		input     []string
+		first     string
+		found     bool
+		index     int
+		last      string
+		lastIndex int
+	}{
+		{"should find matching strings", []string{"a", "bb", "c", "dd"}, "bb", true, 1, "dd", 3},
+		{"should find a single match", []string{"a", "bb", "c"}, "bb", true, 1, "bb", 1},
+		{"should report no match", []string{"a", "c"}, "", false, -1, "", -1},
+		{"should report no match in an empty slice", []string{}, "", false, -1, "", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, found := FindString(test.input, long)
+			require.Equal(t, test.first, res)
+			require.Equal(t, test.found, found)
+			require.Equal(t, test.index, FindIndexString(test.input, long))
+
+			res, found = FindLastString(test.input, long)
+			require.Equal(t, test.last, res)
+			require.Equal(t, test.found, found)
+			require.Equal(t, test.lastIndex, FindLastIndexString(test.input, long))
+
+			chain := NewStringSlice(test.input)
+			res, found = chain.Find(long)
+			require.Equal(t, test.first, res)
+			require.Equal(t, test.found, found)
+			res, found = chain.FindLast(long)
+			require.Equal(t, test.last, res)
+			require.Equal(t, test.found, found)
+			require.Equal(t, test.index, chain.FindIndex(long))
+			require.Equal(t, test.lastIndex, chain.FindLastIndex(long))
+		})
+	}
+}
+
+func TestStringFindZeroValue(t *testing.T) {
+	// a zero value which is found can be told apart from not finding one
+	empty := func(s string, i int) bool { return s == "" }
+
+	res, found := FindString([]string{"a", ""}, empty)
+	require.Equal(t, "", res)
+	require.True(t, found)
+}
+
+func TestStringIndexOf(t *testing.T) {
+	var tests = []struct {
+		name      string
+		input     []string
+		item      string
+		index     int
+		lastIndex int
+	}{
+		{"should find repeated items", []string{"a", "b", "a"}, "a", 0, 2},
+		{"should find single items", []string{"a", "b", "a"}, "b", 1, 1},
+		{"should report missing items", []string{"a", "b"}, "c", -1, -1},
+		{"should report missing items in an empty slice", []string{}, "a", -1, -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.index, IndexOfString(test.input, test.item))
+			require.Equal(t, test.lastIndex, LastIndexOfString(test.input, test.item))
+			require.Equal(t, test.index, NewStringSlice(test.input).IndexOf(test.item))
+			require.Equal(t, test.lastIndex, NewStringSlice(test.input).LastIndexOf(test.item))
+		})
+	}
+}
+
+func TestStringPtrIndexOf(t *testing.T) {
+	input := stringPtrSlice([]string{"a", "b", "a"})
+
+	// pointers to equal values are found, as with Contains
+	require.Equal(t, 0, IndexOfStringPtr(input, stringPtr("a")))
+	require.Equal(t, 2, LastIndexOfStringPtr(input, stringPtr("a")))
+	require.Equal(t, 1, IndexOfStringPtr(input, input[1]))
+	require.Equal(t, -1, IndexOfStringPtr(input, stringPtr("c")))
+
+	res, found := FindStringPtr(input, func(s *string, i int) bool { return *s == "b" })
+	require.True(t, found)
+	require.Same(t, input[1], res)
+
+	res, found = FindStringPtr(input, func(s *string, i int) bool { return false })
+	require.False(t, found)
+	require.Nil(t, res)
+}
+
+func TestTaggedTypeIndexOf(t *testing.T) {
+	// TaggedType is compared using its Equal method, which ignores tags
+	input := []TaggedType{tt("a", "x"), tt("b"), tt("a", "y")}
+
+	require.Equal(t, 0, IndexOfTaggedType(input, tt("a")))
+	require.Equal(t, 2, LastIndexOfTaggedType(input, tt("a")))
+	require.Equal(t, -1, IndexOfTaggedType(input, tt("c")))
+}
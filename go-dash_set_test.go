@@ -0,0 +1,123 @@
+package main
+
+import (
+	"testing"
+
+	. "github.com/jtyers/slice/customtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringSets(t *testing.T) {
+	var tests = []struct {
+		name         string
+		input        []string
+		input2       []string
+		union        []string
+		intersection []string
+		difference   []string
+		xor          []string
+		subset       bool
+		disjoint     bool
+	}{
+		{
+			"should combine overlapping slices in order",
+			[]string{"a", "b", "c", "b"},
+			[]string{"d", "c", "a", "e"},
+			[]string{"a", "b", "c", "d", "e"},
+			[]string{"a", "c"},
+			[]string{"b"},
+			[]string{"b", "d", "e"},
+			false,
+			false,
+		},
+		{
+			"should combine disjoint slices",
+			[]string{"a", "a"},
+			[]string{"b"},
+			[]string{"a", "b"},
+			[]string{},
+			[]string{"a"},
+			[]string{"a", "b"},
+			false,
+			true,
+		},
+		{
+			"should find subsets",
+			[]string{"c", "a"},
+			[]string{"a", "b", "c"},
+			[]string{"c", "a", "b"},
+			[]string{"c", "a"},
+			[]string{},
+			[]string{"b"},
+			true,
+			false,
+		},
+		{
+			"should treat empty slices as the empty set",
+			[]string{},
+			[]string{},
+			[]string{},
+			[]string{},
+			[]string{},
+			[]string{},
+			true,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.union, UnionString(test.input, test.input2))
+			require.Equal(t, test.intersection, IntersectionString(test.input, test.input2))
+			require.Equal(t, test.difference, DifferenceString(test.input, test.input2))
+			require.Equal(t, test.xor, XorString(test.input, test.input2))
+			require.Equal(t, test.subset, IsSubsetString(test.input, test.input2))
+			require.Equal(t, test.disjoint, IsDisjointString(test.input, test.input2))
+
+			chain := NewStringSlice(test.input)
+			require.Equal(t, test.union, chain.Union(test.input2).Value())
+			require.Equal(t, test.intersection, chain.Intersection(test.input2).Value())
+			require.Equal(t, test.difference, chain.Difference(test.input2).Value())
+			require.Equal(t, test.xor, chain.Xor(test.input2).Value())
+			require.Equal(t, test.subset, chain.IsSubset(test.input2))
+			require.Equal(t, test.disjoint, chain.IsDisjoint(test.input2))
+		})
+	}
+}
+
+func TestStringPtrSets(t *testing.T) {
+	a, b, c := stringPtr("a"), stringPtr("b"), stringPtr("c")
+
+	// pointers to equal values are the same element, as with Contains
+	otherA := stringPtr("a")
+
+	union := UnionStringPtr([]*string{a, b}, []*string{otherA, c})
+	require.Len(t, union, 3)
+	require.Same(t, a, union[0])
+	require.Same(t, b, union[1])
+	require.Same(t, c, union[2])
+
+	require.Equal(t, []*string{a}, IntersectionStringPtr([]*string{a, b}, []*string{otherA}))
+	require.Equal(t, []*string{b}, DifferenceStringPtr([]*string{a, b}, []*string{otherA}))
+	require.Equal(t, []*string{b, c}, XorStringPtr([]*string{a, b}, []*string{otherA, c}))
+	require.True(t, IsSubsetStringPtr([]*string{otherA}, []*string{a, b}))
+	require.False(t, IsDisjointStringPtr([]*string{otherA}, []*string{a, b}))
+	require.True(t, IsDisjointStringPtr([]*string{c}, []*string{a, b}))
+}
+
+func TestTaggedTypeSets(t *testing.T) {
+	// TaggedType is compared using its Equal method, which ignores tags
+	input := []TaggedType{tt("a", "x"), tt("b"), tt("a", "y")}
+	input2 := []TaggedType{tt("b", "z"), tt("c")}
+
+	require.Equal(t, []TaggedType{tt("a", "x"), tt("b"), tt("c")}, UnionTaggedType(input, input2))
+	require.Equal(t, []TaggedType{tt("b")}, IntersectionTaggedType(input, input2))
+	require.Equal(t, []TaggedType{tt("a", "x")}, DifferenceTaggedType(input, input2))
+	require.Equal(t, []TaggedType{tt("a", "x"), tt("c")}, XorTaggedType(input, input2))
+	require.False(t, IsSubsetTaggedType(input, input2))
+	require.True(t, IsSubsetTaggedType([]TaggedType{tt("c", "w")}, input2))
+	require.True(t, NewTaggedTypeSlice([]TaggedType{tt("d")}).IsDisjoint(input))
+
+	first, other := tt("a"), tt("a", "x")
+	require.Equal(t, []*TaggedType{&first}, UnionTaggedTypePtr([]*TaggedType{&first}, []*TaggedType{&other}))
+}
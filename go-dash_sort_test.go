@@ -0,0 +1,102 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/jtyers/slice/customtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringSort(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []string
+		output []string
+	}{
+		{"should sort strings", []string{"c", "a", "b"}, []string{"a", "b", "c"}},
+		{"should keep duplicates", []string{"b", "a", "b"}, []string{"a", "b", "b"}},
+		{"should sort an empty slice", []string{}, []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := append([]string{}, test.input...)
+
+			require.Equal(t, test.output, SortString(input))
+			require.Equal(t, test.output, SortStableString(input))
+			require.Equal(t, test.output, NewStringSlice(input).Sort().Value())
+			require.True(t, IsSortedString(SortString(input)))
+
+			// the input is left alone
+			require.Equal(t, test.input, input)
+		})
+	}
+}
+
+func TestStringIsSorted(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []string
+		output bool
+	}{
+		{"should accept sorted strings", []string{"a", "b", "b", "c"}, true},
+		{"should reject unsorted strings", []string{"a", "c", "b"}, false},
+		{"should accept an empty slice", []string{}, true},
+		{"should accept a single string", []string{"z"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.output, IsSortedString(test.input))
+			require.Equal(t, test.output, NewStringSlice(test.input).IsSorted())
+		})
+	}
+}
+
+func TestStringSortBy(t *testing.T) {
+	byLength := func(a, b string) bool { return len(a) < len(b) }
+
+	input := []string{"ccc", "a", "bb", "dd", "e"}
+
+	require.Equal(t, []string{"a", "e", "bb", "dd", "ccc"}, SortStableByString(input, byLength))
+	require.Equal(t, []string{"a", "e", "bb", "dd", "ccc"}, NewStringSlice(input).SortStableBy(byLength).Value())
+	require.True(t, IsSortedByString(SortByString(input, byLength), byLength))
+	require.False(t, IsSortedByString(input, byLength))
+	require.False(t, NewStringSlice(input).IsSortedBy(byLength))
+}
+
+func TestStringSortByKeyInt(t *testing.T) {
+	input := []string{"ccc", "a", "bb", "dd", "e"}
+	key := func(s string) int { return len(s) }
+
+	// sorting by key is stable
+	require.Equal(t, []string{"a", "e", "bb", "dd", "ccc"}, SortStringByKeyInt(input, key))
+	require.Equal(t, []string{"a", "e", "bb", "dd", "ccc"}, NewStringSlice(input).SortByKeyInt(key).Value())
+	require.Equal(t, []string{"ccc", "a", "bb", "dd", "e"}, input)
+}
+
+func TestStringPtrSort(t *testing.T) {
+	input := stringPtrSlice([]string{"c", "a", "b"})
+
+	res := SortStringPtr(input)
+	require.Equal(t, stringPtrSlice([]string{"a", "b", "c"}), res)
+	require.True(t, IsSortedStringPtr(res))
+	require.False(t, IsSortedStringPtr(input))
+
+	// the pointers are moved, not copied
+	require.Same(t, input[1], res[0])
+
+	require.Equal(t, stringPtrSlice([]string{"a", "b", "c"}), NewStringPtrSlice(input).Sort().Value())
+}
+
+func TestCustomTypeSort(t *testing.T) {
+	input := []CustomType{ct("b"), ct("c"), ct("a")}
+
+	// CustomType sorts using its Less method
+	require.Equal(t, []CustomType{ct("a"), ct("b"), ct("c")}, SortCustomType(input))
+	require.True(t, NewCustomTypeSlice(input).Sort().IsSorted())
+
+	upper := func(c CustomType) string { return strings.ToUpper(c.Name) }
+	require.Equal(t, []CustomType{ct("a"), ct("b"), ct("c")}, SortCustomTypeByKeyString(input, upper))
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntTake(t *testing.T) {
+	var tests = []struct {
+		name      string
+		input     []int
+		n         int
+		take      []int
+		takeRight []int
+	}{
+		{"should take from either end", []int{1, 2, 3, 4}, 2, []int{1, 2}, []int{3, 4}},
+		{"should take everything if n is too large", []int{1, 2, 3}, 5, []int{1, 2, 3}, []int{1, 2, 3}},
+		{"should take nothing if n is zero", []int{1, 2, 3}, 0, []int{}, []int{}},
+		{"should take nothing if n is negative", []int{1, 2, 3}, -1, []int{}, []int{}},
+		{"should take nothing from an empty slice", []int{}, 2, []int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.take, TakeInt(test.input, test.n))
+			require.Equal(t, test.takeRight, TakeRightInt(test.input, test.n))
+			require.Equal(t, test.take, NewIntSlice(test.input).Take(test.n).Value())
+			require.Equal(t, test.takeRight, NewIntSlice(test.input).TakeRight(test.n).Value())
+		})
+	}
+}
+
+func TestIntTakeWhile(t *testing.T) {
+	small := func(i int, index int) bool { return i < 3 }
+
+	var tests = []struct {
+		name           string
+		input          []int
+		takeWhile      []int
+		takeRightWhile []int
+		dropWhile      []int
+		dropRightWhile []int
+	}{
+		{
+			"should split at the first element not matching",
+			[]int{1, 2, 3, 1, 2},
+			[]int{1, 2},
+			[]int{1, 2},
+			[]int{3, 1, 2},
+			[]int{1, 2, 3},
+		},
+		{
+			"should take everything if every element matches",
+			[]int{1, 2},
+			[]int{1, 2},
+			[]int{1, 2},
+			[]int{},
+			[]int{},
+		},
+		{
+			"should take nothing if no element matches",
+			[]int{3, 4},
+			[]int{},
+			[]int{},
+			[]int{3, 4},
+			[]int{3, 4},
+		},
+		{
+			"should handle an empty slice",
+			[]int{},
+			[]int{},
+			[]int{},
+			[]int{},
+			[]int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.takeWhile, TakeWhileInt(test.input, small))
+			require.Equal(t, test.takeRightWhile, TakeRightWhileInt(test.input, small))
+			require.Equal(t, test.dropWhile, DropWhileInt(test.input, small))
+			require.Equal(t, test.dropRightWhile, DropRightWhileInt(test.input, small))
+
+			chain := NewIntSlice(test.input)
+			require.Equal(t, test.takeWhile, chain.TakeWhile(small).Value())
+			require.Equal(t, test.takeRightWhile, chain.TakeRightWhile(small).Value())
+			require.Equal(t, test.dropWhile, chain.DropWhile(small).Value())
+			require.Equal(t, test.dropRightWhile, chain.DropRightWhile(small).Value())
+		})
+	}
+}
+
+func TestIntTakeWhileIndex(t *testing.T) {
+	indexes := []int{}
+	TakeRightWhileInt([]int{5, 6, 7}, func(i int, index int) bool {
+		indexes = append(indexes, index)
+		return i > 5
+	})
+	require.Equal(t, []int{2, 1, 0}, indexes)
+}
+
+func TestIntSlice(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []int
+		start  int
+		end    int
+		output []int
+	}{
+		{"should slice between indexes", []int{1, 2, 3, 4, 5}, 1, 3, []int{2, 3}},
+		{"should count negative indexes from the end", []int{1, 2, 3, 4, 5}, -3, -1, []int{3, 4}},
+		{"should mix positive and negative indexes", []int{1, 2, 3, 4, 5}, 1, -1, []int{2, 3, 4}},
+		{"should clamp a start before the beginning", []int{1, 2, 3}, -5, 2, []int{1, 2}},
+		{"should clamp an end past the end", []int{1, 2, 3}, 1, 10, []int{2, 3}},
+		{"should return nothing if start is past the end", []int{1, 2, 3}, 5, 10, []int{}},
+		{"should return nothing if end is before start", []int{1, 2, 3}, 2, 1, []int{}},
+		{"should return nothing if end is before the beginning", []int{1, 2, 3}, 0, -5, []int{}},
+		{"should slice an empty slice", []int{}, -1, 1, []int{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.output, SliceInt(test.input, test.start, test.end))
+			require.Equal(t, test.output, NewIntSlice(test.input).Slice(test.start, test.end).Value())
+		})
+	}
+}
+
+func TestIntTakeCopies(t *testing.T) {
+	input := []int{1, 2, 3}
+
+	res := TakeInt(input, 2)
+	res[0] = 9
+	require.Equal(t, []int{1, 2, 3}, input)
+}
@@ -1,8 +1,14 @@
 package main
 
-//go:generate ./slice -out go-dash_generated_test.go -package main -type string -dir .
+//go:generate ./slice -out go-dash_generated_int_test.go -package main -type int -map-to string -zip-with string,*string -dir .
+//go:generate ./slice -out go-dash_generated_test.go -package main -type string -map-to int,*string -key-types int,bool -dir .
 //go:generate ./slice -out go-dash_generated_ptr_test.go -package main -type *string -dir .
-//go:generate ./slice -out go-dash_generated_custom_test.go -package main -type CustomType -import github.com/jtyers/slice/customtype -dir .
+//go:generate ./slice -out go-dash_generated_custom_test.go -package main -type CustomType -key-types string -import github.com/jtyers/slice/customtype -dir .
+//go:generate ./slice -out go-dash_generated_tagged_test.go -package main -type TaggedType,*TaggedType -import github.com/jtyers/slice/customtype -dir .
+//go:generate ./slice -out go-dash_generated_nested_test.go -package main -type Row,Grid -methods Flatten,FlattenDeep,FlatMap -import github.com/jtyers/slice/customtype -dir .
+//go:generate ./slice -out go-dash_generated_numeric_test.go -package main -type Cents,uint8 -import github.com/jtyers/slice/customtype -dir .
+//go:generate ./slice -out go-dash_generated_generic_test.go -package main -type float64,*float64 -generic -dir .
+//go:generate ./slice -out go-dash_generated_int64_test.go -package main -type int64,*int64 -iter -dir .
 
 import (
 	"strings"
@@ -79,6 +85,18 @@ func TestStringDrop(t *testing.T) {
 			2,
 			[]string{"third"},
 		},
+		{
+			"should drop everything if n is too large",
+			[]string{"first", "second", "third"},
+			5,
+			[]string{},
+		},
+		{
+			"should drop nothing if n is negative",
+			[]string{"first", "second", "third"},
+			-1,
+			[]string{"first", "second", "third"},
+		},
 	}
 
 	for _, test := range tests {
@@ -103,6 +121,18 @@ func TestStringDropRight(t *testing.T) {
 			2,
 			[]string{"first"},
 		},
+		{
+			"should drop everything if n is too large",
+			[]string{"first", "second", "third"},
+			5,
+			[]string{},
+		},
+		{
+			"should drop nothing if n is negative",
+			[]string{"first", "second", "third"},
+			-1,
+			[]string{"first", "second", "third"},
+		},
 	}
 
 	for _, test := range tests {
@@ -591,7 +621,7 @@ func TestStringPtrUniq(t *testing.T) {
 }
 
 func ct(name string) CustomType {
-	return CustomType{name}
+	return CustomType{Name: name}
 }
 
 func TestCustomTypeConcat(t *testing.T) {
@@ -780,7 +810,7 @@ func TestCustomTypeMap(t *testing.T) {
 			[]CustomType{ct("first"), ct("second"), ct("third")},
 			func(s CustomType, i int) CustomType {
 				result := strings.ToUpper(s.Name)
-				return CustomType{result}
+				return CustomType{Name: result}
 			},
 			[]CustomType{ct("FIRST"), ct("SECOND"), ct("THIRD")},
 		},
@@ -872,3 +902,91 @@ func TestCustomTypeUniq(t *testing.T) {
 		require.Equal(t, got.Value(), test.output)
 	}
 }
+
+func tt(name string, tags ...string) TaggedType {
+	return TaggedType{Name: name, Tags: tags}
+}
+
+func TestTaggedTypeContains(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []TaggedType
+		test   TaggedType
+		output bool
+	}{
+		{
+			"should return true if an Equal item is present",
+			[]TaggedType{tt("first", "a"), tt("second", "b"), tt("third")},
+			tt("second", "c"),
+			true,
+		},
+		{
+			"should return false if no Equal item is present",
+			[]TaggedType{tt("first", "a"), tt("second", "b"), tt("third")},
+			tt("fourth", "a"),
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewTaggedTypeSlice(test.input)
+
+			got := c.Contains(test.test)
+
+			require.Equal(t, got, test.output)
+		})
+	}
+}
+
+func TestTaggedTypeUniq(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []TaggedType
+		output []TaggedType
+	}{
+		{
+			"should filter out Equal items where present",
+			[]TaggedType{tt("first", "a"), tt("second"), tt("first", "b"), tt("third"), tt("second", "c")},
+			[]TaggedType{tt("first", "a"), tt("second"), tt("third")},
+		},
+		{
+			"should filter out Equal items where none are present",
+			[]TaggedType{tt("first"), tt("second"), tt("third")},
+			[]TaggedType{tt("first"), tt("second"), tt("third")},
+		},
+	}
+
+	for _, test := range tests {
+		c := NewTaggedTypeSlice(test.input)
+
+		got := c.Uniq()
+
+		require.Equal(t, got.Value(), test.output)
+	}
+}
+
+func TestTaggedTypePtrContains(t *testing.T) {
+	first := tt("first", "a")
+	slice := []*TaggedType{&first, {Name: "second"}}
+
+	var tests = []struct {
+		name   string
+		test   *TaggedType
+		output bool
+	}{
+		{"should return true for the same pointer", &first, true},
+		{"should return true for a pointer to an Equal item", &TaggedType{Name: "second", Tags: []string{"b"}}, true},
+		{"should return false if no Equal item is present", &TaggedType{Name: "third"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewTaggedTypePtrSlice(slice)
+
+			got := c.Contains(test.test)
+
+			require.Equal(t, got, test.output)
+		})
+	}
+}
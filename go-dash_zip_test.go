@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntZipString(t *testing.T) {
+	var tests = []struct {
+		name   string
+		input  []int
+		input2 []string
+		output []PairIntString
+	}{
+		{
+			"should pair up elements",
+			[]int{1, 2},
+			[]string{"a", "b"},
+			[]PairIntString{{1, "a"}, {2, "b"}},
+		},
+		{
+			"should stop at the end of the shorter slice",
+			[]int{1, 2, 3},
+			[]string{"a", "b"},
+			[]PairIntString{{1, "a"}, {2, "b"}},
+		},
+		{
+			"should stop at the end of a shorter first slice",
+			[]int{1},
+			[]string{"a", "b"},
+			[]PairIntString{{1, "a"}},
+		},
+		{
+			"should zip empty slices",
+			[]int{},
+			[]string{},
+			[]PairIntString{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.output, ZipIntString(test.input, test.input2))
+			require.Equal(t, test.output, NewIntSlice(test.input).ZipString(test.input2).Value())
+		})
+	}
+}
+
+func TestIntUnzipString(t *testing.T) {
+	ints, strings := UnzipIntString([]PairIntString{{1, "a"}, {2, "b"}})
+	require.Equal(t, []int{1, 2}, ints)
+	require.Equal(t, []string{"a", "b"}, strings)
+
+	ints, strings = UnzipIntString([]PairIntString{})
+	require.Equal(t, []int{}, ints)
+	require.Equal(t, []string{}, strings)
+}
+
+func TestPairIntStringChain(t *testing.T) {
+	intChain, stringChain := NewIntSlice([]int{1, 2, 3}).
+		ZipString([]string{"a", "b", "c"}).
+		Filter(func(pair PairIntString, index int) bool { return pair.First != 2 }).
+		Unzip()
+
+	require.Equal(t, []int{1, 3}, intChain.Value())
+	require.Equal(t, []string{"c", "a"}, stringChain.Reverse().Value())
+}
+
+func TestIntZipStringPtr(t *testing.T) {
+	input2 := stringPtrSlice([]string{"a", "b"})
+
+	pairs := ZipIntStringPtr([]int{1, 2}, input2)
+	require.Len(t, pairs, 2)
+	require.Same(t, input2[1], pairs[1].Second)
+
+	_, stringChain := NewPairIntStringPtrSlice(pairs).Unzip()
+	require.True(t, stringChain.isPtr)
+	require.Equal(t, input2, stringChain.Value())
+}
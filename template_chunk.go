@@ -0,0 +1,95 @@
+package main
+
+// CHUNK_TEMPLATE defines methods splitting a slice into smaller slices, and
+// chainXSlices, the chain type for [][]X which they return. Its methods
+// work on each slice in turn; Reduce turns each into a single element, for
+// example to compute moving statistics over windows. Window depends on Chunk
+// for the chain type.
+const CHUNK_TEMPLATE = `{{ define "Chunk" -}}
+type chain{{ .TypeNameCapitalised }}Slices struct {
+	value [][]{{ .TypeLiteral }}
+	err error
+}
+
+func New{{ .TypeNameCapitalised }}Slices(slices [][]{{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }}Slices {
+	return &chain{{ .TypeNameCapitalised }}Slices{value: slices}
+}
+
+func (c *chain{{ .TypeNameCapitalised }}Slices) Value() [][]{{ .TypeLiteral }} {
+	return c.value
+}
+
+func (c *chain{{ .TypeNameCapitalised }}Slices) Result() ([][]{{ .TypeLiteral }}, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chain{{ .TypeNameCapitalised }}Slices) Each(fn func([]{{ .TypeLiteral }}, int)) {
+	for index, entry := range c.value {
+		fn(entry, index)
+	}
+}
+
+func (c *chain{{ .TypeNameCapitalised }}Slices) Map(fn func([]{{ .TypeLiteral }}, int) []{{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }}Slices {
+	res := make([][]{{ .TypeLiteral }}, 0, len(c.value))
+	for index, entry := range c.value {
+		res = append(res, fn(entry, index))
+	}
+	return &chain{{ .TypeNameCapitalised }}Slices{value: res, err: c.err}
+}
+
+func (c *chain{{ .TypeNameCapitalised }}Slices) Reduce(fn func({{ .TypeLiteral }},{{ .TypeLiteral }},int){{ .TypeLiteral }}, initial {{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	res := make([]{{ .TypeLiteral }}, 0, len(c.value))
+	for _, entry := range c.value {
+		acc := initial
+		for index, val := range entry {
+			acc = fn(acc, val, index)
+		}
+		res = append(res, acc)
+	}
+	return &chain{{ .TypeNameCapitalised }}{value: res{{ if .IsPtr }}, isPtr: true{{ end }}, err: c.err}
+}
+
+func Chunk{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, size int) (res [][]{{ .TypeLiteral }}) {
+	res = [][]{{ .TypeLiteral }}{}
+	if size < 1 {
+		return
+	}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunk := make([]{{ .TypeLiteral }}, 0, end-start)
+		res = append(res, append(chunk, slice[start:end]...))
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Chunk(size int) *chain{{ .TypeNameCapitalised }}Slices {
+	return &chain{{ .TypeNameCapitalised }}Slices{value: Chunk{{ .TypeNameCapitalised }}(c.value, size), err: c.err}
+}
+
+{{ end }}
+
+{{ define "Window" -}}
+func Window{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, size int, step int) (res [][]{{ .TypeLiteral }}) {
+	res = [][]{{ .TypeLiteral }}{}
+	if size < 1 || step < 1 {
+		return
+	}
+	for start := 0; start+size <= len(slice); start += step {
+		window := make([]{{ .TypeLiteral }}, 0, size)
+		res = append(res, append(window, slice[start:start+size]...))
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Window(size int, step int) *chain{{ .TypeNameCapitalised }}Slices {
+	return &chain{{ .TypeNameCapitalised }}Slices{value: Window{{ .TypeNameCapitalised }}(c.value, size, step), err: c.err}
+}
+
+{{ end }}
+`
@@ -0,0 +1,164 @@
+package main
+
+// CONTEXT_TEMPLATE defines variants of the Err and Parallel methods which
+// take a context.Context, checking it before each element and returning its
+// error once it is done. The Err variants are built on MapErr and so on, and
+// the Parallel ones on ParallelForEach, which they depend on.
+const CONTEXT_TEMPLATE = `{{ define "MapCtx" -}}
+func MapCtx{{ .TypeNameCapitalised }}(ctx context.Context, slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error)) ([]{{ .TypeLiteral }}, error) {
+	return MapErr{{ .TypeNameCapitalised }}(slice, func(entry {{ .TypeLiteral }}, index int) ({{ .TypeLiteral }}, error) {
+		if err := ctx.Err(); err != nil {
+			return entry, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) MapCtx(ctx context.Context, fn func({{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error)) *chain{{ .TypeNameCapitalised }} {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapCtx{{ .TypeNameCapitalised }}(ctx, c.value, fn)
+	return &chain{{ .TypeNameCapitalised }}{value: res, err: err}
+}
+
+{{ end }}
+
+{{ define "FilterCtx" -}}
+func FilterCtx{{ .TypeNameCapitalised }}(ctx context.Context, slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) (bool, error)) ([]{{ .TypeLiteral }}, error) {
+	return FilterErr{{ .TypeNameCapitalised }}(slice, func(entry {{ .TypeLiteral }}, index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) FilterCtx(ctx context.Context, fn func({{ .TypeLiteral }}, int) (bool, error)) *chain{{ .TypeNameCapitalised }} {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterCtx{{ .TypeNameCapitalised }}(ctx, c.value, fn)
+	return &chain{{ .TypeNameCapitalised }}{value: res, err: err}
+}
+
+{{ end }}
+
+{{ define "ReduceCtx" -}}
+func ReduceCtx{{ .TypeNameCapitalised }}(ctx context.Context, slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, {{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error), initial {{ .TypeLiteral }}) ({{ .TypeLiteral }}, error) {
+	return ReduceErr{{ .TypeNameCapitalised }}(slice, func(acc {{ .TypeLiteral }}, entry {{ .TypeLiteral }}, index int) ({{ .TypeLiteral }}, error) {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		return fn(acc, entry, index)
+	}, initial)
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) ReduceCtx(ctx context.Context, fn func({{ .TypeLiteral }}, {{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error), initial {{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceCtx{{ .TypeNameCapitalised }}(ctx, c.value, fn, initial)
+	if err != nil {
+		return &chain{{ .TypeNameCapitalised }}{err: err}
+	}
+	return &chain{{ .TypeNameCapitalised }}{value: []{{ .TypeLiteral }}{res}}
+}
+
+{{ end }}
+
+{{ define "ForEachCtx" -}}
+func ForEachCtx{{ .TypeNameCapitalised }}(ctx context.Context, slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) error) error {
+	return ForEachErr{{ .TypeNameCapitalised }}(slice, func(entry {{ .TypeLiteral }}, index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(entry, index)
+	})
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) ForEachCtx(ctx context.Context, fn func({{ .TypeLiteral }}, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachCtx{{ .TypeNameCapitalised }}(ctx, c.value, fn)
+}
+
+{{ end }}
+
+{{ define "ParallelMapCtx" -}}
+func ParallelMapCtx{{ .TypeNameCapitalised }}(ctx context.Context, slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error), workers int) ([]{{ .TypeLiteral }}, error) {
+	res := make([]{{ .TypeLiteral }}, len(slice))
+	err := runParallel{{ .TypeNameCapitalised }}(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			res[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *parallel{{ .TypeNameCapitalised }}) MapCtx(ctx context.Context, fn func({{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error)) *parallel{{ .TypeNameCapitalised }} {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelMapCtx{{ .TypeNameCapitalised }}(ctx, c.value, fn, c.workers)
+	return &parallel{{ .TypeNameCapitalised }}{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+{{ end }}
+
+{{ define "ParallelFilterCtx" -}}
+func ParallelFilterCtx{{ .TypeNameCapitalised }}(ctx context.Context, slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) (bool, error), workers int) ([]{{ .TypeLiteral }}, error) {
+	keep := make([]bool, len(slice))
+	err := runParallel{{ .TypeNameCapitalised }}(len(slice), workers, func(index int) (err error) {
+		if err = ctx.Err(); err == nil {
+			keep[index], err = fn(slice[index], index)
+		}
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]{{ .TypeLiteral }}, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+func (c *parallel{{ .TypeNameCapitalised }}) FilterCtx(ctx context.Context, fn func({{ .TypeLiteral }}, int) (bool, error)) *parallel{{ .TypeNameCapitalised }} {
+	if c.err != nil {
+		return c
+	}
+	res, err := ParallelFilterCtx{{ .TypeNameCapitalised }}(ctx, c.value, fn, c.workers)
+	return &parallel{{ .TypeNameCapitalised }}{value: res, isPtr: c.isPtr, err: err, workers: c.workers}
+}
+
+{{ end }}
+
+{{ define "ParallelForEachCtx" -}}
+func ParallelForEachCtx{{ .TypeNameCapitalised }}(ctx context.Context, slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) error, workers int) error {
+	return runParallel{{ .TypeNameCapitalised }}(len(slice), workers, func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(slice[index], index)
+	})
+}
+
+func (c *parallel{{ .TypeNameCapitalised }}) ForEachCtx(ctx context.Context, fn func({{ .TypeLiteral }}, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ParallelForEachCtx{{ .TypeNameCapitalised }}(ctx, c.value, fn, c.workers)
+}
+
+{{ end }}
+`
@@ -0,0 +1,97 @@
+package main
+
+// ERR_TEMPLATE defines variants of Map, Filter and Reduce, and ForEachErr,
+// whose callbacks may fail. Each stops at the first error and returns it. On
+// a chain, the error is kept and returned by Result, and the value is emptied
+// so later methods do no work.
+const ERR_TEMPLATE = `{{ define "MapErr" -}}
+func MapErr{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error)) (res []{{ .TypeLiteral }}, err error) {
+	res = make([]{{ .TypeLiteral }}, 0, len(slice))
+	for index, entry := range slice {
+		var val {{ .TypeLiteral }}
+		if val, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		res = append(res, val)
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) MapErr(fn func({{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error)) *chain{{ .TypeNameCapitalised }} {
+	if c.err != nil {
+		return c
+	}
+	res, err := MapErr{{ .TypeNameCapitalised }}(c.value, fn)
+	return &chain{{ .TypeNameCapitalised }}{value: res, err: err}
+}
+
+{{ end }}
+
+{{ define "FilterErr" -}}
+func FilterErr{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) (bool, error)) (res []{{ .TypeLiteral }}, err error) {
+	res = make([]{{ .TypeLiteral }}, 0, len(slice))
+	for index, entry := range slice {
+		var keep bool
+		if keep, err = fn(entry, index); err != nil {
+			return nil, err
+		}
+		if keep {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) FilterErr(fn func({{ .TypeLiteral }}, int) (bool, error)) *chain{{ .TypeNameCapitalised }} {
+	if c.err != nil {
+		return c
+	}
+	res, err := FilterErr{{ .TypeNameCapitalised }}(c.value, fn)
+	return &chain{{ .TypeNameCapitalised }}{value: res, err: err}
+}
+
+{{ end }}
+
+{{ define "ReduceErr" -}}
+func ReduceErr{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, {{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error), initial {{ .TypeLiteral }}) (res {{ .TypeLiteral }}, err error) {
+	acc := initial
+	for index, entry := range slice {
+		if acc, err = fn(acc, entry, index); err != nil {
+			return
+		}
+	}
+	return acc, nil
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) ReduceErr(fn func({{ .TypeLiteral }}, {{ .TypeLiteral }}, int) ({{ .TypeLiteral }}, error), initial {{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	if c.err != nil {
+		return c
+	}
+	res, err := ReduceErr{{ .TypeNameCapitalised }}(c.value, fn, initial)
+	if err != nil {
+		return &chain{{ .TypeNameCapitalised }}{err: err}
+	}
+	return &chain{{ .TypeNameCapitalised }}{value: []{{ .TypeLiteral }}{res}}
+}
+
+{{ end }}
+
+{{ define "ForEachErr" -}}
+func ForEachErr{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) error) error {
+	for index, entry := range slice {
+		if err := fn(entry, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) ForEachErr(fn func({{ .TypeLiteral }}, int) error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return ForEachErr{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+`
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FLATTEN_TEMPLATE defines methods joining slices into one. Flatten is chained
+// from the chain of slices Chunk defines, so depends on it. FlattenDeep is
+// only generated for types made of slices, flattening them all the way down;
+// it returns the innermost element type, whose chain may not exist, so is not
+// chainable. Each preallocates its result with a pass to count its length.
+const FLATTEN_TEMPLATE = `{{ define "Flatten" -}}
+func Flatten{{ .TypeNameCapitalised }}(slices [][]{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	l := 0
+	for _, slice := range slices {
+		l += len(slice)
+	}
+	res = make([]{{ .TypeLiteral }}, 0, l)
+	for _, slice := range slices {
+		res = append(res, slice...)
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}Slices) Flatten() *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Flatten{{ .TypeNameCapitalised }}(c.value){{ if .IsPtr }}, isPtr: true{{ end }}, err: c.err}
+}
+
+{{ end }}
+
+{{ define "FlattenDeep" -}}
+func FlattenDeep{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res []{{ .SliceElem }}) {
+	l := 0
+	{{ .SliceLoops "slice" "l += len(inner)" }}
+	res = make([]{{ .SliceElem }}, 0, l)
+	{{ .SliceLoops "slice" "res = append(res, inner...)" }}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) FlattenDeep() []{{ .SliceElem }} {
+	return FlattenDeep{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "FlatMap" -}}
+func FlatMap{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	mapped := make([][]{{ .TypeLiteral }}, len(slice))
+	l := 0
+	for index, entry := range slice {
+		mapped[index] = fn(entry, index)
+		l += len(mapped[index])
+	}
+	res = make([]{{ .TypeLiteral }}, 0, l)
+	for _, entries := range mapped {
+		res = append(res, entries...)
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) FlatMap(fn func({{ .TypeLiteral }}, int) []{{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: FlatMap{{ .TypeNameCapitalised }}(c.value, fn){{ if .IsPtr }}, isPtr: true{{ end }}, err: c.err}
+}
+
+{{ end }}
+`
+
+// SliceLoops returns nested loops over slice, a slice of the type, down to
+// the innermost slices of a type with a SliceDepth, running body on each as
+// inner.
+func (d *typeData) SliceLoops(slice string, body string) string {
+	var buf strings.Builder
+	from := slice
+	for depth := 0; depth < d.SliceDepth; depth++ {
+		v := fmt.Sprintf("v%d", depth)
+		if depth == d.SliceDepth-1 {
+			v = "inner"
+		}
+		fmt.Fprintf(&buf, "for _, %s := range %s {\n", v, from)
+		from = v
+	}
+	buf.WriteString(body)
+	buf.WriteString(strings.Repeat("\n}", d.SliceDepth))
+	return buf.String()
+}
@@ -0,0 +1,77 @@
+package main
+
+// GROUP_TEMPLATE defines methods aggregating a slice by key. GroupBy, KeyBy
+// and CountBy are generated for each comparable type given with -key-types;
+// Partition needs no key type.
+const GROUP_TEMPLATE = `{{ define "GroupBy" -}}
+{{ range .KeyTypes }}{{ if .Comparable -}}
+func Group{{ $.TypeNameCapitalised }}By{{ .TypeNameCapitalised }}(slice []{{ $.TypeLiteral }}, fn func({{ $.TypeLiteral }}) {{ .TypeLiteral }}) (res map[{{ .TypeLiteral }}][]{{ $.TypeLiteral }}) {
+	res = make(map[{{ .TypeLiteral }}][]{{ $.TypeLiteral }})
+	for _, entry := range slice {
+		key := fn(entry)
+		res[key] = append(res[key], entry)
+	}
+	return
+}
+
+func (c *chain{{ $.TypeNameCapitalised }}) GroupBy{{ .TypeNameCapitalised }}(fn func({{ $.TypeLiteral }}) {{ .TypeLiteral }}) map[{{ .TypeLiteral }}][]{{ $.TypeLiteral }} {
+	return Group{{ $.TypeNameCapitalised }}By{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}{{ end -}}
+{{ end }}
+
+{{ define "KeyBy" -}}
+{{ range .KeyTypes }}{{ if .Comparable -}}
+func Key{{ $.TypeNameCapitalised }}By{{ .TypeNameCapitalised }}(slice []{{ $.TypeLiteral }}, fn func({{ $.TypeLiteral }}) {{ .TypeLiteral }}) (res map[{{ .TypeLiteral }}]{{ $.TypeLiteral }}) {
+	res = make(map[{{ .TypeLiteral }}]{{ $.TypeLiteral }}, len(slice))
+	for _, entry := range slice {
+		res[fn(entry)] = entry
+	}
+	return
+}
+
+func (c *chain{{ $.TypeNameCapitalised }}) KeyBy{{ .TypeNameCapitalised }}(fn func({{ $.TypeLiteral }}) {{ .TypeLiteral }}) map[{{ .TypeLiteral }}]{{ $.TypeLiteral }} {
+	return Key{{ $.TypeNameCapitalised }}By{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}{{ end -}}
+{{ end }}
+
+{{ define "CountBy" -}}
+{{ range .KeyTypes }}{{ if .Comparable -}}
+func Count{{ $.TypeNameCapitalised }}By{{ .TypeNameCapitalised }}(slice []{{ $.TypeLiteral }}, fn func({{ $.TypeLiteral }}) {{ .TypeLiteral }}) (res map[{{ .TypeLiteral }}]int) {
+	res = make(map[{{ .TypeLiteral }}]int)
+	for _, entry := range slice {
+		res[fn(entry)]++
+	}
+	return
+}
+
+func (c *chain{{ $.TypeNameCapitalised }}) CountBy{{ .TypeNameCapitalised }}(fn func({{ $.TypeLiteral }}) {{ .TypeLiteral }}) map[{{ .TypeLiteral }}]int {
+	return Count{{ $.TypeNameCapitalised }}By{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}{{ end -}}
+{{ end }}
+
+{{ define "Partition" -}}
+func Partition{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) (yes []{{ .TypeLiteral }}, no []{{ .TypeLiteral }}) {
+	yes = []{{ .TypeLiteral }}{}
+	no = []{{ .TypeLiteral }}{}
+	for index, entry := range slice {
+		if fn(entry, index) {
+			yes = append(yes, entry)
+		} else {
+			no = append(no, entry)
+		}
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Partition(fn func({{ .TypeLiteral }}, int) bool) ([]{{ .TypeLiteral }}, []{{ .TypeLiteral }}) {
+	return Partition{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+`
@@ -0,0 +1,104 @@
+package main
+
+// ITER_TEMPLATE defines methods working with the iterators of Go 1.23, so
+// chains can be ranged over and used with the slices and maps packages. They
+// are only generated with -iter, which builds the file with go1.23 alone.
+// FilterSeq, MapSeq and TakeSeq are lazy, doing their work as the sequence
+// they return is ranged over.
+const ITER_TEMPLATE = `{{ define "All" -}}
+func All{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) iter.Seq2[int, {{ .TypeLiteral }}] {
+	return func(yield func(int, {{ .TypeLiteral }}) bool) {
+		for index, entry := range slice {
+			if !yield(index, entry) {
+				return
+			}
+		}
+	}
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) All() iter.Seq2[int, {{ .TypeLiteral }}] {
+	return All{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "Values" -}}
+func Values{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) iter.Seq[{{ .TypeLiteral }}] {
+	return func(yield func({{ .TypeLiteral }}) bool) {
+		for _, entry := range slice {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Values() iter.Seq[{{ .TypeLiteral }}] {
+	return Values{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "FromSeq" -}}
+func FromSeq{{ .TypeNameCapitalised }}(seq iter.Seq[{{ .TypeLiteral }}]) *chain{{ .TypeNameCapitalised }} {
+	res := []{{ .TypeLiteral }}{}
+	for entry := range seq {
+		res = append(res, entry)
+	}
+	return {{ .NewFuncName }}(res)
+}
+
+{{ end }}
+
+{{ define "FilterSeq" -}}
+func FilterSeq{{ .TypeNameCapitalised }}(seq iter.Seq[{{ .TypeLiteral }}], fn func({{ .TypeLiteral }}, int) bool) iter.Seq[{{ .TypeLiteral }}] {
+	return func(yield func({{ .TypeLiteral }}) bool) {
+		index := 0
+		for entry := range seq {
+			keep := fn(entry, index)
+			index++
+			if keep && !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+{{ end }}
+
+{{ define "MapSeq" -}}
+func MapSeq{{ .TypeNameCapitalised }}(seq iter.Seq[{{ .TypeLiteral }}], fn func({{ .TypeLiteral }}, int) {{ .TypeLiteral }}) iter.Seq[{{ .TypeLiteral }}] {
+	return func(yield func({{ .TypeLiteral }}) bool) {
+		index := 0
+		for entry := range seq {
+			if !yield(fn(entry, index)) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+{{ end }}
+
+{{ define "TakeSeq" -}}
+func TakeSeq{{ .TypeNameCapitalised }}(seq iter.Seq[{{ .TypeLiteral }}], n int) iter.Seq[{{ .TypeLiteral }}] {
+	return func(yield func({{ .TypeLiteral }}) bool) {
+		if n < 1 {
+			return
+		}
+		taken := 0
+		for entry := range seq {
+			if !yield(entry) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
+
+{{ end }}
+`
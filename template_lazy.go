@@ -0,0 +1,200 @@
+package main
+
+// LAZY_TEMPLATE defines lazyX, a chain which records each step and runs them
+// only when Value is called, allocating just the result. Steps taking one
+// element at a time are fused into a single pass over the slice; those needing
+// the whole slice (Reverse, DropRight and TakeRight) work on the result in
+// place between passes.
+const LAZY_TEMPLATE = `{{ define "Lazy" -}}
+// lazy{{ .TypeNameCapitalised }}Step is a step of a lazy{{ .TypeNameCapitalised }}. Either start returns a
+// function taking each element in turn, and returning what it becomes, whether
+// to keep it, and whether any more elements could be kept; or apply changes the
+// whole slice in place.
+type lazy{{ .TypeNameCapitalised }}Step struct {
+	start func() func({{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool)
+	apply func([]{{ .TypeLiteral }}) []{{ .TypeLiteral }}
+}
+
+type lazy{{ .TypeNameCapitalised }} struct {
+	isPtr bool
+	err error
+	source []{{ .TypeLiteral }}
+	steps []lazy{{ .TypeNameCapitalised }}Step
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Lazy() *lazy{{ .TypeNameCapitalised }} {
+	return &lazy{{ .TypeNameCapitalised }}{source: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) then(step lazy{{ .TypeNameCapitalised }}Step) *lazy{{ .TypeNameCapitalised }} {
+	// copied, so that chains can branch from c
+	steps := make([]lazy{{ .TypeNameCapitalised }}Step, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+	return &lazy{{ .TypeNameCapitalised }}{source: c.source, isPtr: c.isPtr, err: c.err, steps: append(steps, step)}
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) Filter(fn func({{ .TypeLiteral }}, int) bool) *lazy{{ .TypeNameCapitalised }} {
+	return c.then(lazy{{ .TypeNameCapitalised }}Step{start: func() func({{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+		index := 0
+		return func(entry {{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+			keep := fn(entry, index)
+			index++
+			return entry, keep, true
+		}
+	}})
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) Map(fn func({{ .TypeLiteral }}, int) {{ .TypeLiteral }}) *lazy{{ .TypeNameCapitalised }} {
+	return c.then(lazy{{ .TypeNameCapitalised }}Step{start: func() func({{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+		index := 0
+		return func(entry {{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+			entry = fn(entry, index)
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) Drop(n int) *lazy{{ .TypeNameCapitalised }} {
+	return c.then(lazy{{ .TypeNameCapitalised }}Step{start: func() func({{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+		seen := 0
+		return func(entry {{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+			seen++
+			return entry, seen > n, true
+		}
+	}})
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) Take(n int) *lazy{{ .TypeNameCapitalised }} {
+	return c.then(lazy{{ .TypeNameCapitalised }}Step{start: func() func({{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+		taken := 0
+		return func(entry {{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+			if taken >= n {
+				return entry, false, false
+			}
+			taken++
+			return entry, true, taken < n
+		}
+	}})
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) TakeWhile(fn func({{ .TypeLiteral }}, int) bool) *lazy{{ .TypeNameCapitalised }} {
+	return c.then(lazy{{ .TypeNameCapitalised }}Step{start: func() func({{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+		index := 0
+		return func(entry {{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+			if !fn(entry, index) {
+				return entry, false, false
+			}
+			index++
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) DropWhile(fn func({{ .TypeLiteral }}, int) bool) *lazy{{ .TypeNameCapitalised }} {
+	return c.then(lazy{{ .TypeNameCapitalised }}Step{start: func() func({{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+		index := 0
+		dropping := true
+		return func(entry {{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool) {
+			if dropping && fn(entry, index) {
+				index++
+				return entry, false, true
+			}
+			dropping = false
+			return entry, true, true
+		}
+	}})
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) Reverse() *lazy{{ .TypeNameCapitalised }} {
+	return c.then(lazy{{ .TypeNameCapitalised }}Step{apply: func(slice []{{ .TypeLiteral }}) []{{ .TypeLiteral }} {
+		for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+			slice[i], slice[j] = slice[j], slice[i]
+		}
+		return slice
+	}})
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) DropRight(n int) *lazy{{ .TypeNameCapitalised }} {
+	return c.then(lazy{{ .TypeNameCapitalised }}Step{apply: func(slice []{{ .TypeLiteral }}) []{{ .TypeLiteral }} {
+		l := len(slice) - n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		return slice[:l]
+	}})
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) TakeRight(n int) *lazy{{ .TypeNameCapitalised }} {
+	return c.then(lazy{{ .TypeNameCapitalised }}Step{apply: func(slice []{{ .TypeLiteral }}) []{{ .TypeLiteral }} {
+		l := n
+		if l < 0 {
+			l = 0
+		}
+		if l > len(slice) {
+			l = len(slice)
+		}
+		copy(slice, slice[len(slice)-l:])
+		return slice[:l]
+	}})
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) Value() []{{ .TypeLiteral }} {
+	res := make([]{{ .TypeLiteral }}, 0, len(c.source))
+
+	// the first pass reads from the source, later ones from res
+	in := c.source
+	for i := 0; i < len(c.steps); {
+		if apply := c.steps[i].apply; apply != nil {
+			if len(res) == 0 {
+				res = append(res, in...)
+			}
+			res = apply(res)
+			in = res
+			i++
+			continue
+		}
+
+		var fns []func({{ .TypeLiteral }}) ({{ .TypeLiteral }}, bool, bool)
+		for ; i < len(c.steps) && c.steps[i].apply == nil; i++ {
+			fns = append(fns, c.steps[i].start())
+		}
+
+		// elements are never written ahead of where they are read, so res
+		// can be both read and written
+		out := res[:0]
+		for _, entry := range in {
+			keep, stop := true, false
+			for _, fn := range fns {
+				var more bool
+				entry, keep, more = fn(entry)
+				stop = stop || !more
+				if !keep {
+					break
+				}
+			}
+			if keep {
+				out = append(out, entry)
+			}
+			if stop {
+				break
+			}
+		}
+		res, in = out, out
+	}
+
+	if len(c.steps) == 0 {
+		res = append(res, c.source...)
+	}
+	return res
+}
+
+func (c *lazy{{ .TypeNameCapitalised }}) Eager() *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: c.Value(), isPtr: c.isPtr, err: c.err}
+}
+
+{{ end }}
+`
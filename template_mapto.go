@@ -0,0 +1,45 @@
+package main
+
+// MAP_TO_TEMPLATE defines methods mapping and reducing a type to the types
+// given with -map-to. Each target's chain type must also be generated into
+// the same package.
+const MAP_TO_TEMPLATE = `{{ define "MapTo" -}}
+{{ range .MapTo -}}
+func Map{{ $.TypeNameCapitalised }}To{{ .TypeNameCapitalised }}(slice []{{ $.TypeLiteral }}, fn func({{ $.TypeLiteral }},int){{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	{{ if $.Generic }}return generic.Map(slice, fn)
+	{{- else -}}
+	res = make([]{{ .TypeLiteral }}, 0, len(slice))
+	for index, entry := range slice {
+		res = append(res, fn(entry, index))
+	}
+	return
+	{{- end }}
+}
+
+func (c *chain{{ $.TypeNameCapitalised }}) MapTo{{ .TypeNameCapitalised }}(fn func({{ $.TypeLiteral }},int){{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Map{{ $.TypeNameCapitalised }}To{{ .TypeNameCapitalised }}(c.value, fn){{ if .IsPtr }}, isPtr: true{{ end }}, err: c.err}
+}
+
+{{ end -}}
+{{ end }}
+
+{{ define "ReduceTo" -}}
+{{ range .MapTo -}}
+func Reduce{{ $.TypeNameCapitalised }}To{{ .TypeNameCapitalised }}(slice []{{ $.TypeLiteral }}, fn func({{ .TypeLiteral }},{{ $.TypeLiteral }},int){{ .TypeLiteral }}, initial {{ .TypeLiteral }}) (res {{ .TypeLiteral }}) {
+	{{ if $.Generic }}return generic.Reduce(slice, fn, initial)
+	{{- else -}}
+	res = initial
+	for index, entry := range slice {
+		res = fn(res, entry, index)
+	}
+	return
+	{{- end }}
+}
+
+func (c *chain{{ $.TypeNameCapitalised }}) ReduceTo{{ .TypeNameCapitalised }}(fn func({{ .TypeLiteral }},{{ $.TypeLiteral }},int){{ .TypeLiteral }}, initial {{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: []{{ .TypeLiteral }}{Reduce{{ $.TypeNameCapitalised }}To{{ .TypeNameCapitalised }}(c.value, fn, initial)}{{ if .IsPtr }}, isPtr: true{{ end }}, err: c.err}
+}
+
+{{ end -}}
+{{ end }}
+`
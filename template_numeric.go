@@ -0,0 +1,232 @@
+package main
+
+// NUMERIC_TEMPLATE defines aggregates over numbers. Min and Max use the same
+// natural order as Sort, and MinBy and MaxBy a less function, so apply more
+// widely; the rest are only generated for integer and float types (including
+// named ones). Statistics are float64 whatever the type, and like Min and Max
+// report false for empty slices, which have none.
+const NUMERIC_TEMPLATE = `{{ define "Sum" -}}
+func Sum{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res {{ .TypeLiteral }}) {
+	for _, entry := range slice {
+		res += entry
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Sum() {{ .TypeLiteral }} {
+	return Sum{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "SumChecked" -}}
+func SumChecked{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res {{ .TypeLiteral }}, ok bool) {
+	for _, entry := range slice {
+		sum := res + entry
+		{{ if .Unsigned -}}
+		if sum < res {
+			return 0, false
+		}
+		{{- else -}}
+		if (entry > 0 && sum < res) || (entry < 0 && sum > res) {
+			return 0, false
+		}
+		{{- end }}
+		res = sum
+	}
+	return res, true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) SumChecked() ({{ .TypeLiteral }}, bool) {
+	return SumChecked{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "Product" -}}
+func Product{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res {{ .TypeLiteral }}) {
+	res = 1
+	for _, entry := range slice {
+		res *= entry
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Product() {{ .TypeLiteral }} {
+	return Product{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "Min" -}}
+func Min{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res {{ .TypeLiteral }}, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if {{ .Less "entry" "res" }} {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Min() ({{ .TypeLiteral }}, bool) {
+	return Min{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "Max" -}}
+func Max{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res {{ .TypeLiteral }}, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if {{ .Less "res" "entry" }} {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Max() ({{ .TypeLiteral }}, bool) {
+	return Max{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "MinBy" -}}
+func MinBy{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, less func(a, b {{ .TypeLiteral }}) bool) (res {{ .TypeLiteral }}, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(entry, res) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) MinBy(less func(a, b {{ .TypeLiteral }}) bool) ({{ .TypeLiteral }}, bool) {
+	return MinBy{{ .TypeNameCapitalised }}(c.value, less)
+}
+
+{{ end }}
+
+{{ define "MaxBy" -}}
+func MaxBy{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, less func(a, b {{ .TypeLiteral }}) bool) (res {{ .TypeLiteral }}, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	res = slice[0]
+	for _, entry := range slice[1:] {
+		if less(res, entry) {
+			res = entry
+		}
+	}
+	return res, true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) MaxBy(less func(a, b {{ .TypeLiteral }}) bool) ({{ .TypeLiteral }}, bool) {
+	return MaxBy{{ .TypeNameCapitalised }}(c.value, less)
+}
+
+{{ end }}
+
+{{ define "Mean" -}}
+func Mean{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	for _, entry := range slice {
+		res += float64(entry)
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Mean() (float64, bool) {
+	return Mean{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "Median" -}}
+func Median{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (float64, bool) {
+	return Percentile{{ .TypeNameCapitalised }}(slice, 50)
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Median() (float64, bool) {
+	return Median{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "Percentile" -}}
+func Percentile{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, p float64) (res float64, ok bool) {
+	if len(slice) == 0 || !(p >= 0 && p <= 100) {
+		return
+	}
+
+	sorted := make([]float64, len(slice))
+	for index, entry := range slice {
+		sorted[index] = float64(entry)
+	}
+	sort.Float64s(sorted)
+
+	// interpolate between the closest ranks
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower == len(sorted)-1 {
+		return sorted[lower], true
+	}
+	return sorted[lower] + (sorted[lower+1]-sorted[lower])*(rank-float64(lower)), true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Percentile(p float64) (float64, bool) {
+	return Percentile{{ .TypeNameCapitalised }}(c.value, p)
+}
+
+{{ end }}
+
+{{ define "Variance" -}}
+func Variance{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res float64, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+
+	mean := 0.0
+	for _, entry := range slice {
+		mean += float64(entry)
+	}
+	mean /= float64(len(slice))
+
+	for _, entry := range slice {
+		d := float64(entry) - mean
+		res += d * d
+	}
+	return res / float64(len(slice)), true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Variance() (float64, bool) {
+	return Variance{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "StdDev" -}}
+func StdDev{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (float64, bool) {
+	variance, ok := Variance{{ .TypeNameCapitalised }}(slice)
+	return math.Sqrt(variance), ok
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) StdDev() (float64, bool) {
+	return StdDev{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+`
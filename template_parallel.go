@@ -0,0 +1,134 @@
+package main
+
+// PARALLEL_TEMPLATE defines methods calling fn for each element from a
+// bounded pool of goroutines, and parallelX, the chain type returned by
+// chainX.Parallel. Elements are handed out one at a time, so uneven work is
+// shared evenly, and results keep the order of the input. ParallelMap and
+// ParallelFilter depend on ParallelForEach for the pool and chain type.
+const PARALLEL_TEMPLATE = `{{ define "ParallelForEach" -}}
+// runParallel{{ .TypeNameCapitalised }} calls fn for each index below n from at most workers
+// goroutines, returning once all calls have. No more calls are started once
+// one returns an error, and the first is returned. A panic in fn is raised
+// again here.
+func runParallel{{ .TypeNameCapitalised }}(n int, workers int, fn func(int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	var errOnce, panicOnce sync.Once
+	var err error
+	var recovered interface{}
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&failed, 1)
+					panicOnce.Do(func() { recovered = r })
+				}
+			}()
+			for atomic.LoadInt32(&failed) == 0 {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= n {
+					return
+				}
+				if e := fn(index); e != nil {
+					atomic.StoreInt32(&failed, 1)
+					errOnce.Do(func() { err = e })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+	return err
+}
+
+func ParallelForEach{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int), workers int) {
+	runParallel{{ .TypeNameCapitalised }}(len(slice), workers, func(index int) error {
+		fn(slice[index], index)
+		return nil
+	})
+}
+
+type parallel{{ .TypeNameCapitalised }} struct {
+	isPtr bool
+	err error
+	value []{{ .TypeLiteral }}
+	workers int
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Parallel(workers int) *parallel{{ .TypeNameCapitalised }} {
+	return &parallel{{ .TypeNameCapitalised }}{value: c.value, isPtr: c.isPtr, err: c.err, workers: workers}
+}
+
+func (c *parallel{{ .TypeNameCapitalised }}) ForEach(fn func({{ .TypeLiteral }}, int)) {
+	ParallelForEach{{ .TypeNameCapitalised }}(c.value, fn, c.workers)
+}
+
+func (c *parallel{{ .TypeNameCapitalised }}) Value() []{{ .TypeLiteral }} {
+	return c.value
+}
+
+func (c *parallel{{ .TypeNameCapitalised }}) Result() ([]{{ .TypeLiteral }}, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *parallel{{ .TypeNameCapitalised }}) Sequential() *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: c.value, isPtr: c.isPtr, err: c.err}
+}
+
+{{ end }}
+
+{{ define "ParallelMap" -}}
+func ParallelMap{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) {{ .TypeLiteral }}, workers int) (res []{{ .TypeLiteral }}) {
+	res = make([]{{ .TypeLiteral }}, len(slice))
+	runParallel{{ .TypeNameCapitalised }}(len(slice), workers, func(index int) error {
+		res[index] = fn(slice[index], index)
+		return nil
+	})
+	return
+}
+
+func (c *parallel{{ .TypeNameCapitalised }}) Map(fn func({{ .TypeLiteral }}, int) {{ .TypeLiteral }}) *parallel{{ .TypeNameCapitalised }} {
+	return &parallel{{ .TypeNameCapitalised }}{value: ParallelMap{{ .TypeNameCapitalised }}(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+{{ end }}
+
+{{ define "ParallelFilter" -}}
+func ParallelFilter{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool, workers int) (res []{{ .TypeLiteral }}) {
+	keep := make([]bool, len(slice))
+	runParallel{{ .TypeNameCapitalised }}(len(slice), workers, func(index int) error {
+		keep[index] = fn(slice[index], index)
+		return nil
+	})
+
+	res = make([]{{ .TypeLiteral }}, 0, len(slice))
+	for index, entry := range slice {
+		if keep[index] {
+			res = append(res, entry)
+		}
+	}
+	return
+}
+
+func (c *parallel{{ .TypeNameCapitalised }}) Filter(fn func({{ .TypeLiteral }}, int) bool) *parallel{{ .TypeNameCapitalised }} {
+	return &parallel{{ .TypeNameCapitalised }}{value: ParallelFilter{{ .TypeNameCapitalised }}(c.value, fn, c.workers), isPtr: c.isPtr, err: c.err, workers: c.workers}
+}
+
+{{ end }}
+`
@@ -0,0 +1,69 @@
+package main
+
+// PREDICATE_TEMPLATE defines methods asking how many elements satisfy a
+// predicate, without building the filtered slice. All but Count stop at the
+// first element deciding the answer.
+const PREDICATE_TEMPLATE = `{{ define "Every" -}}
+func Every{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) bool {
+	for index, entry := range slice {
+		if !fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Every(fn func({{ .TypeLiteral }}, int) bool) bool {
+	return Every{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+
+{{ define "Some" -}}
+func Some{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Some(fn func({{ .TypeLiteral }}, int) bool) bool {
+	return Some{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+
+{{ define "None" -}}
+func None{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) bool {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) None(fn func({{ .TypeLiteral }}, int) bool) bool {
+	return None{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+
+{{ define "Count" -}}
+func Count{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) (res int) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			res++
+		}
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Count(fn func({{ .TypeLiteral }}, int) bool) int {
+	return Count{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+`
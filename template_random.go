@@ -0,0 +1,102 @@
+package main
+
+// RANDOM_TEMPLATE defines methods making random choices. Each takes its source
+// of randomness, which a *rand.Rand satisfies, so results can be made
+// repeatable; it is declared inline to keep types generated into the same
+// package from clashing over a shared declaration.
+const RANDOM_TEMPLATE = `{{ define "Shuffle" -}}
+func Shuffle{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, rnd interface{ Intn(int) int }) (res []{{ .TypeLiteral }}) {
+	res = make([]{{ .TypeLiteral }}, len(slice))
+	copy(res, slice)
+	for index := len(res) - 1; index > 0; index-- {
+		other := rnd.Intn(index + 1)
+		res[index], res[other] = res[other], res[index]
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Shuffle(rnd interface{ Intn(int) int }) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Shuffle{{ .TypeNameCapitalised }}(c.value, rnd), err: c.err}
+}
+
+{{ end }}
+
+{{ define "Sample" -}}
+func Sample{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, rnd interface{ Intn(int) int }) (res {{ .TypeLiteral }}, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[rnd.Intn(len(slice))], true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Sample(rnd interface{ Intn(int) int }) ({{ .TypeLiteral }}, bool) {
+	return Sample{{ .TypeNameCapitalised }}(c.value, rnd)
+}
+
+{{ end }}
+
+{{ define "SampleN" -}}
+func SampleN{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, n int, rnd interface{ Intn(int) int }) (res []{{ .TypeLiteral }}) {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(slice) {
+		n = len(slice)
+	}
+
+	// reservoir sampling, so only the sample is held however large the
+	// slice is
+	res = make([]{{ .TypeLiteral }}, n)
+	copy(res, slice[:n])
+	for index := n; index < len(slice); index++ {
+		if other := rnd.Intn(index + 1); other < n {
+			res[other] = slice[index]
+		}
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) SampleN(n int, rnd interface{ Intn(int) int }) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: SampleN{{ .TypeNameCapitalised }}(c.value, n, rnd), err: c.err}
+}
+
+{{ end }}
+
+{{ define "WeightedChoice" -}}
+func WeightedChoice{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, weight func({{ .TypeLiteral }}) float64, rnd interface{ Float64() float64 }) (res {{ .TypeLiteral }}, ok bool) {
+	// elements without a positive weight are never chosen
+	weights := make([]float64, len(slice))
+	total := 0.0
+	for index, entry := range slice {
+		if w := weight(entry); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+	if !(total > 0) {
+		return
+	}
+
+	target := rnd.Float64() * total
+	last := -1
+	for index, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return slice[index], true
+		}
+		target -= w
+		last = index
+	}
+
+	// rounding can leave target just past the end
+	return slice[last], true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) WeightedChoice(weight func({{ .TypeLiteral }}) float64, rnd interface{ Float64() float64 }) ({{ .TypeLiteral }}, bool) {
+	return WeightedChoice{{ .TypeNameCapitalised }}(c.value, weight, rnd)
+}
+
+{{ end }}
+`
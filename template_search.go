@@ -0,0 +1,103 @@
+package main
+
+// SEARCH_TEMPLATE defines methods finding elements. Unlike First and Last,
+// these report whether anything was found: Find and FindLast with a flag, the
+// others with an index of -1. IndexOf and LastIndexOf compare elements as
+// Contains does.
+const SEARCH_TEMPLATE = `{{ define "Find" -}}
+func Find{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) (res {{ .TypeLiteral }}, found bool) {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return entry, true
+		}
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Find(fn func({{ .TypeLiteral }}, int) bool) ({{ .TypeLiteral }}, bool) {
+	return Find{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+
+{{ define "FindIndex" -}}
+func FindIndex{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) int {
+	for index, entry := range slice {
+		if fn(entry, index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) FindIndex(fn func({{ .TypeLiteral }}, int) bool) int {
+	return FindIndex{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+
+{{ define "FindLast" -}}
+func FindLast{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) (res {{ .TypeLiteral }}, found bool) {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return slice[index], true
+		}
+	}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) FindLast(fn func({{ .TypeLiteral }}, int) bool) ({{ .TypeLiteral }}, bool) {
+	return FindLast{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+
+{{ define "FindLastIndex" -}}
+func FindLastIndex{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		if fn(slice[index], index) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) FindLastIndex(fn func({{ .TypeLiteral }}, int) bool) int {
+	return FindLastIndex{{ .TypeNameCapitalised }}(c.value, fn)
+}
+
+{{ end }}
+
+{{ define "IndexOf" -}}
+func IndexOf{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, item {{ .TypeLiteral }}) int {
+	for index, val := range slice {
+		if {{ .Equal "val" "item" }} {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) IndexOf(item {{ .TypeLiteral }}) int {
+	return IndexOf{{ .TypeNameCapitalised }}(c.value, item)
+}
+
+{{ end }}
+
+{{ define "LastIndexOf" -}}
+func LastIndexOf{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, item {{ .TypeLiteral }}) int {
+	for index := len(slice) - 1; index >= 0; index-- {
+		val := slice[index]
+		if {{ .Equal "val" "item" }} {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) LastIndexOf(item {{ .TypeLiteral }}) int {
+	return LastIndexOf{{ .TypeNameCapitalised }}(c.value, item)
+}
+
+{{ end }}
+`
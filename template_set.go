@@ -0,0 +1,258 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SET_TEMPLATE defines methods treating slices as sets. Results never hold
+// duplicates, and keep elements in the order they first appear. Comparable
+// types (or, for pointers, the types pointed to) are hashed; types relying on
+// an Equal method are compared pairwise instead.
+const SET_TEMPLATE = `{{ define "setContains" -}}
+	contains := func(slice []{{ .TypeLiteral }}, item {{ .TypeLiteral }}) bool {
+		for _, val := range slice {
+			if {{ .Equal "val" "item" }} {
+				return true
+			}
+		}
+		return false
+	}
+{{- end }}
+
+{{ define "Union" -}}
+func Union{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, slice2 []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	res = []{{ .TypeLiteral }}{}
+	{{ if .Comparable -}}
+	seen := make(map[{{ .SetKeyType }}]bool)
+	for _, entry := range slice {
+		if !seen[{{ .SetKey "entry" }}] {
+			seen[{{ .SetKey "entry" }}] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !seen[{{ .SetKey "entry" }}] {
+			seen[{{ .SetKey "entry" }}] = true
+			res = append(res, entry)
+		}
+	}
+	{{- else -}}
+	{{ template "setContains" . }}
+	for _, entry := range slice {
+		if !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	{{- end }}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Union(slice2 []{{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Union{{ .TypeNameCapitalised }}(c.value, slice2), err: c.err}
+}
+
+{{ end }}
+
+{{ define "Intersection" -}}
+func Intersection{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, slice2 []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	res = []{{ .TypeLiteral }}{}
+	{{ if .Comparable -}}
+	in := make(map[{{ .SetKeyType }}]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[{{ .SetKey "entry" }}] = true
+	}
+	seen := make(map[{{ .SetKeyType }}]bool)
+	for _, entry := range slice {
+		if in[{{ .SetKey "entry" }}] && !seen[{{ .SetKey "entry" }}] {
+			seen[{{ .SetKey "entry" }}] = true
+			res = append(res, entry)
+		}
+	}
+	{{- else -}}
+	{{ template "setContains" . }}
+	for _, entry := range slice {
+		if contains(slice2, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	{{- end }}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Intersection(slice2 []{{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Intersection{{ .TypeNameCapitalised }}(c.value, slice2), err: c.err}
+}
+
+{{ end }}
+
+{{ define "Difference" -}}
+func Difference{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, slice2 []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	res = []{{ .TypeLiteral }}{}
+	{{ if .Comparable -}}
+	seen := make(map[{{ .SetKeyType }}]bool, len(slice2))
+	for _, entry := range slice2 {
+		seen[{{ .SetKey "entry" }}] = true
+	}
+	for _, entry := range slice {
+		if !seen[{{ .SetKey "entry" }}] {
+			seen[{{ .SetKey "entry" }}] = true
+			res = append(res, entry)
+		}
+	}
+	{{- else -}}
+	{{ template "setContains" . }}
+	for _, entry := range slice {
+		if !contains(slice2, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	{{- end }}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Difference(slice2 []{{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Difference{{ .TypeNameCapitalised }}(c.value, slice2), err: c.err}
+}
+
+{{ end }}
+
+{{ define "Xor" -}}
+func Xor{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, slice2 []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	res = []{{ .TypeLiteral }}{}
+	{{ if .Comparable -}}
+	in := make(map[{{ .SetKeyType }}]bool, len(slice))
+	for _, entry := range slice {
+		in[{{ .SetKey "entry" }}] = true
+	}
+	in2 := make(map[{{ .SetKeyType }}]bool, len(slice2))
+	for _, entry := range slice2 {
+		in2[{{ .SetKey "entry" }}] = true
+	}
+	seen := make(map[{{ .SetKeyType }}]bool)
+	for _, entry := range slice {
+		if !in2[{{ .SetKey "entry" }}] && !seen[{{ .SetKey "entry" }}] {
+			seen[{{ .SetKey "entry" }}] = true
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !in[{{ .SetKey "entry" }}] && !seen[{{ .SetKey "entry" }}] {
+			seen[{{ .SetKey "entry" }}] = true
+			res = append(res, entry)
+		}
+	}
+	{{- else -}}
+	{{ template "setContains" . }}
+	for _, entry := range slice {
+		if !contains(slice2, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	for _, entry := range slice2 {
+		if !contains(slice, entry) && !contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	{{- end }}
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Xor(slice2 []{{ .TypeLiteral }}) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Xor{{ .TypeNameCapitalised }}(c.value, slice2), err: c.err}
+}
+
+{{ end }}
+
+{{ define "IsSubset" -}}
+func IsSubset{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, slice2 []{{ .TypeLiteral }}) bool {
+	{{ if .Comparable -}}
+	in := make(map[{{ .SetKeyType }}]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[{{ .SetKey "entry" }}] = true
+	}
+	for _, entry := range slice {
+		if !in[{{ .SetKey "entry" }}] {
+			return false
+		}
+	}
+	{{- else -}}
+	{{ template "setContains" . }}
+	for _, entry := range slice {
+		if !contains(slice2, entry) {
+			return false
+		}
+	}
+	{{- end }}
+	return true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) IsSubset(slice2 []{{ .TypeLiteral }}) bool {
+	return IsSubset{{ .TypeNameCapitalised }}(c.value, slice2)
+}
+
+{{ end }}
+
+{{ define "IsDisjoint" -}}
+func IsDisjoint{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, slice2 []{{ .TypeLiteral }}) bool {
+	{{ if .Comparable -}}
+	in := make(map[{{ .SetKeyType }}]bool, len(slice2))
+	for _, entry := range slice2 {
+		in[{{ .SetKey "entry" }}] = true
+	}
+	for _, entry := range slice {
+		if in[{{ .SetKey "entry" }}] {
+			return false
+		}
+	}
+	{{- else -}}
+	{{ template "setContains" . }}
+	for _, entry := range slice {
+		if contains(slice2, entry) {
+			return false
+		}
+	}
+	{{- end }}
+	return true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) IsDisjoint(slice2 []{{ .TypeLiteral }}) bool {
+	return IsDisjoint{{ .TypeNameCapitalised }}(c.value, slice2)
+}
+
+{{ end }}
+`
+
+// SetKeyType returns the type of map key used to hash the type: the type
+// pointed to for pointers, so pointers to equal values are the same element.
+func (d *typeData) SetKeyType() string {
+	return strings.TrimPrefix(d.TypeLiteral, "*")
+}
+
+// SetKey returns an expression for the map key of v.
+func (d *typeData) SetKey(v string) string {
+	if d.IsPtr {
+		return "*" + v
+	}
+	return v
+}
+
+// Equal returns an expression comparing a and b as Contains does: pointers are
+// equal if they are the same, or point to equal values.
+func (d *typeData) Equal(a string, b string) string {
+	switch {
+	case d.IsPtr && d.EqualMethod:
+		return fmt.Sprintf("%s == %s || %s.Equal(*%s)", a, b, a, b)
+	case d.IsPtr:
+		return fmt.Sprintf("%s == %s || *%s == *%s", a, b, a, b)
+	case d.EqualMethod:
+		return fmt.Sprintf("%s.Equal(%s)", a, b)
+	default:
+		return fmt.Sprintf("%s == %s", a, b)
+	}
+}
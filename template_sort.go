@@ -0,0 +1,155 @@
+package main
+
+import "fmt"
+
+// SORT_TEMPLATE defines sorting methods. Sort, SortStable and IsSorted use the
+// type's natural order, so are only generated for ordered types or those with
+// a Less or Compare method; the By variants take a less function instead, and
+// SortByKey is generated for each ordered type given with -key-types. Like
+// everything else, sorting returns a new slice.
+const SORT_TEMPLATE = `{{ define "Sort" -}}
+func Sort{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	res = make([]{{ .TypeLiteral }}, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return {{ .Less "res[i]" "res[j]" }}
+	})
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Sort() *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Sort{{ .TypeNameCapitalised }}(c.value), err: c.err}
+}
+
+{{ end }}
+
+{{ define "SortStable" -}}
+func SortStable{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) (res []{{ .TypeLiteral }}) {
+	res = make([]{{ .TypeLiteral }}, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return {{ .Less "res[i]" "res[j]" }}
+	})
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) SortStable() *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: SortStable{{ .TypeNameCapitalised }}(c.value), err: c.err}
+}
+
+{{ end }}
+
+{{ define "IsSorted" -}}
+func IsSorted{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}) bool {
+	for index := 1; index < len(slice); index++ {
+		if {{ .Less "slice[index]" "slice[index-1]" }} {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) IsSorted() bool {
+	return IsSorted{{ .TypeNameCapitalised }}(c.value)
+}
+
+{{ end }}
+
+{{ define "SortBy" -}}
+func SortBy{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, less func(a, b {{ .TypeLiteral }}) bool) (res []{{ .TypeLiteral }}) {
+	res = make([]{{ .TypeLiteral }}, len(slice))
+	copy(res, slice)
+	sort.Slice(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) SortBy(less func(a, b {{ .TypeLiteral }}) bool) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: SortBy{{ .TypeNameCapitalised }}(c.value, less), err: c.err}
+}
+
+{{ end }}
+
+{{ define "SortStableBy" -}}
+func SortStableBy{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, less func(a, b {{ .TypeLiteral }}) bool) (res []{{ .TypeLiteral }}) {
+	res = make([]{{ .TypeLiteral }}, len(slice))
+	copy(res, slice)
+	sort.SliceStable(res, func(i, j int) bool {
+		return less(res[i], res[j])
+	})
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) SortStableBy(less func(a, b {{ .TypeLiteral }}) bool) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: SortStableBy{{ .TypeNameCapitalised }}(c.value, less), err: c.err}
+}
+
+{{ end }}
+
+{{ define "IsSortedBy" -}}
+func IsSortedBy{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, less func(a, b {{ .TypeLiteral }}) bool) bool {
+	for index := 1; index < len(slice); index++ {
+		if less(slice[index], slice[index-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) IsSortedBy(less func(a, b {{ .TypeLiteral }}) bool) bool {
+	return IsSortedBy{{ .TypeNameCapitalised }}(c.value, less)
+}
+
+{{ end }}
+
+{{ define "SortByKey" -}}
+{{ range .KeyTypes }}{{ if or .Ordered .LessMethod .CompareMethod -}}
+func Sort{{ $.TypeNameCapitalised }}ByKey{{ .TypeNameCapitalised }}(slice []{{ $.TypeLiteral }}, key func({{ $.TypeLiteral }}) {{ .TypeLiteral }}) (res []{{ $.TypeLiteral }}) {
+	keys := make([]{{ .TypeLiteral }}, len(slice))
+	order := make([]int, len(slice))
+	for index, entry := range slice {
+		keys[index] = key(entry)
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return {{ .Less "keys[order[i]]" "keys[order[j]]" }}
+	})
+	res = make([]{{ $.TypeLiteral }}, len(slice))
+	for index, from := range order {
+		res[index] = slice[from]
+	}
+	return
+}
+
+func (c *chain{{ $.TypeNameCapitalised }}) SortByKey{{ .TypeNameCapitalised }}(key func({{ $.TypeLiteral }}) {{ .TypeLiteral }}) *chain{{ $.TypeNameCapitalised }} {
+	return &chain{{ $.TypeNameCapitalised }}{value: Sort{{ $.TypeNameCapitalised }}ByKey{{ .TypeNameCapitalised }}(c.value, key), err: c.err}
+}
+
+{{ end }}{{ end -}}
+{{ end }}
+`
+
+// Less returns an expression comparing a and b in the type's natural order,
+// dereferencing pointers.
+func (d *typeData) Less(a string, b string) string {
+	if d.IsPtr {
+		switch {
+		case d.Ordered:
+			return fmt.Sprintf("*%s < *%s", a, b)
+		case d.LessMethod:
+			return fmt.Sprintf("%s.Less(*%s)", a, b)
+		default:
+			return fmt.Sprintf("%s.Compare(*%s) < 0", a, b)
+		}
+	}
+
+	switch {
+	case d.Ordered:
+		return fmt.Sprintf("%s < %s", a, b)
+	case d.LessMethod:
+		return fmt.Sprintf("%s.Less(%s)", a, b)
+	default:
+		return fmt.Sprintf("%s.Compare(%s) < 0", a, b)
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+// TAKE_TEMPLATE defines methods taking or dropping part of a slice, completing
+// Drop and DropRight. Counts and indexes out of range are clamped as Drop does,
+// so never panic.
+const TAKE_TEMPLATE = `{{ define "Take" -}}
+func Take{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, n int) (res []{{ .TypeLiteral }}) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]{{ .TypeLiteral }}, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Take(n int) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Take{{ .TypeNameCapitalised }}(c.value, n), err: c.err}
+}
+
+{{ end }}
+
+{{ define "TakeRight" -}}
+func TakeRight{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, n int) (res []{{ .TypeLiteral }}) {
+	l := n
+	if l < 0 {
+		l = 0
+	}
+	if l > len(slice) {
+		l = len(slice)
+	}
+	res = make([]{{ .TypeLiteral }}, 0, l)
+	res = append(res, slice[len(slice)-l:]...)
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) TakeRight(n int) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: TakeRight{{ .TypeNameCapitalised }}(c.value, n), err: c.err}
+}
+
+{{ end }}
+
+{{ define "TakeWhile" -}}
+func TakeWhile{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) (res []{{ .TypeLiteral }}) {
+	l := 0
+	for l < len(slice) && fn(slice[l], l) {
+		l++
+	}
+	res = make([]{{ .TypeLiteral }}, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) TakeWhile(fn func({{ .TypeLiteral }}, int) bool) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: TakeWhile{{ .TypeNameCapitalised }}(c.value, fn), err: c.err}
+}
+
+{{ end }}
+
+{{ define "TakeRightWhile" -}}
+func TakeRightWhile{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) (res []{{ .TypeLiteral }}) {
+	start := len(slice)
+	for start > 0 && fn(slice[start-1], start-1) {
+		start--
+	}
+	res = make([]{{ .TypeLiteral }}, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) TakeRightWhile(fn func({{ .TypeLiteral }}, int) bool) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: TakeRightWhile{{ .TypeNameCapitalised }}(c.value, fn), err: c.err}
+}
+
+{{ end }}
+
+{{ define "DropWhile" -}}
+func DropWhile{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) (res []{{ .TypeLiteral }}) {
+	start := 0
+	for start < len(slice) && fn(slice[start], start) {
+		start++
+	}
+	res = make([]{{ .TypeLiteral }}, 0, len(slice)-start)
+	res = append(res, slice[start:]...)
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) DropWhile(fn func({{ .TypeLiteral }}, int) bool) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: DropWhile{{ .TypeNameCapitalised }}(c.value, fn), err: c.err}
+}
+
+{{ end }}
+
+{{ define "DropRightWhile" -}}
+func DropRightWhile{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, fn func({{ .TypeLiteral }}, int) bool) (res []{{ .TypeLiteral }}) {
+	l := len(slice)
+	for l > 0 && fn(slice[l-1], l-1) {
+		l--
+	}
+	res = make([]{{ .TypeLiteral }}, 0, l)
+	res = append(res, slice[:l]...)
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) DropRightWhile(fn func({{ .TypeLiteral }}, int) bool) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: DropRightWhile{{ .TypeNameCapitalised }}(c.value, fn), err: c.err}
+}
+
+{{ end }}
+
+{{ define "Slice" -}}
+func Slice{{ .TypeNameCapitalised }}(slice []{{ .TypeLiteral }}, start int, end int) (res []{{ .TypeLiteral }}) {
+	// negative indexes count back from the end
+	if start < 0 {
+		start += len(slice)
+	}
+	if end < 0 {
+		end += len(slice)
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if start > len(slice) {
+		start = len(slice)
+	}
+	if end > len(slice) {
+		end = len(slice)
+	}
+	if end < start {
+		end = start
+	}
+	res = make([]{{ .TypeLiteral }}, 0, end-start)
+	res = append(res, slice[start:end]...)
+	return
+}
+
+func (c *chain{{ .TypeNameCapitalised }}) Slice(start int, end int) *chain{{ .TypeNameCapitalised }} {
+	return &chain{{ .TypeNameCapitalised }}{value: Slice{{ .TypeNameCapitalised }}(c.value, start, end), err: c.err}
+}
+
+{{ end }}
+`
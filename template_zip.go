@@ -0,0 +1,81 @@
+package main
+
+// ZIP_TEMPLATE defines methods pairing up elements with those of another
+// type, for each type given with -zip-with. Zip generates a Pair struct and a
+// chain type for it; Unzip depends on Zip for these.
+const ZIP_TEMPLATE = `{{ define "Zip" -}}
+{{ range .ZipWith -}}
+type Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }} struct {
+	First  {{ $.TypeLiteral }}
+	Second {{ .TypeLiteral }}
+}
+
+type chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }} struct {
+	value []Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}
+	err error
+}
+
+func NewPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}Slice(slice []Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}) *chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }} {
+	return &chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}{value: slice}
+}
+
+func (c *chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}) Value() []Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }} {
+	return c.value
+}
+
+func (c *chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}) Result() ([]Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.value, nil
+}
+
+func (c *chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}) Filter(fn func(Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}, int) bool) *chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }} {
+	res := make([]Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}, 0, len(c.value))
+	for index, entry := range c.value {
+		if fn(entry, index) {
+			res = append(res, entry)
+		}
+	}
+	return &chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}{value: res, err: c.err}
+}
+
+func Zip{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}(slice []{{ $.TypeLiteral }}, slice2 []{{ .TypeLiteral }}) (res []Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}) {
+	l := len(slice)
+	if len(slice2) < l {
+		l = len(slice2)
+	}
+	res = make([]Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}, l)
+	for index := range res {
+		res[index] = Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}{First: slice[index], Second: slice2[index]}
+	}
+	return
+}
+
+func (c *chain{{ $.TypeNameCapitalised }}) Zip{{ .TypeNameCapitalised }}(slice2 []{{ .TypeLiteral }}) *chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }} {
+	return &chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}{value: Zip{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}(c.value, slice2), err: c.err}
+}
+
+{{ end -}}
+{{ end }}
+
+{{ define "Unzip" -}}
+{{ range .ZipWith -}}
+func Unzip{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}(pairs []Pair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}) (res []{{ $.TypeLiteral }}, res2 []{{ .TypeLiteral }}) {
+	res = make([]{{ $.TypeLiteral }}, len(pairs))
+	res2 = make([]{{ .TypeLiteral }}, len(pairs))
+	for index, pair := range pairs {
+		res[index] = pair.First
+		res2[index] = pair.Second
+	}
+	return
+}
+
+func (c *chainPair{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}) Unzip() (*chain{{ $.TypeNameCapitalised }}, *chain{{ .TypeNameCapitalised }}) {
+	res, res2 := Unzip{{ $.TypeNameCapitalised }}{{ .TypeNameCapitalised }}(c.value)
+	return &chain{{ $.TypeNameCapitalised }}{value: res{{ if $.IsPtr }}, isPtr: true{{ end }}, err: c.err}, &chain{{ .TypeNameCapitalised }}{value: res2{{ if .IsPtr }}, isPtr: true{{ end }}, err: c.err}
+}
+
+{{ end -}}
+{{ end }}
+`
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// typeVarPrefix names the variables declared to look up each type in a file.
+const typeVarPrefix = "sliceGeneratorType"
+
+// loadTypes type-checks type literals as they would be seen from the
+// generated file f: with its imports, and alongside the other files of the
+// package it is generated into. The result is in the same order as literals.
+func loadTypes(f *outputFile, literals []string) ([]types.Type, error) {
+	fset := token.NewFileSet()
+	dir := filepath.Dir(f.Path)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s\n\n", f.Package)
+	for _, imp := range f.Imports {
+		fmt.Fprintf(&buf, "import . %q\n", imp)
+	}
+	for i, literal := range literals {
+		fmt.Fprintf(&buf, "\nvar %s%d %s\n", typeVarPrefix, i, literal)
+	}
+
+	filename := filepath.Join(dir, filepath.Base(f.Path))
+	file, err := parser.ParseFile(fset, filename, buf.Bytes(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	files := append(packageFiles(fset, dir, f.Package, false, []string{filename}), file)
+
+	// the rest of the package may well not compile until its generated code
+	// exists, so only errors about our own file are of interest
+	var errs []types.Error
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			if terr, ok := err.(types.Error); ok && terr.Fset.Position(terr.Pos).Filename == filename {
+				errs = append(errs, terr)
+			}
+		},
+	}
+
+	pkg, _ := conf.Check(f.Package, fset, files, nil)
+
+	res := make([]types.Type, len(literals))
+	for i, literal := range literals {
+		obj := pkg.Scope().Lookup(fmt.Sprintf("%s%d", typeVarPrefix, i))
+		if obj.Type() == types.Typ[types.Invalid] {
+			line := fset.Position(obj.Pos()).Line
+
+			msgs := []string{}
+			for _, terr := range errs {
+				if terr.Fset.Position(terr.Pos).Line == line {
+					msgs = append(msgs, terr.Msg)
+				}
+			}
+			return nil, fmt.Errorf("cannot load type %s: %s", literal, strings.Join(msgs, "; "))
+		}
+		res[i] = obj.Type()
+	}
+
+	return res, nil
+}
+
+// packageFiles parses the Go files in dir belonging to pkgName, optionally
+// including test files, and leaving out those in exclude. Files that cannot be
+// parsed are skipped.
+func packageFiles(fset *token.FileSet, dir string, pkgName string, includeTests bool, exclude []string) []*ast.File {
+	filter := func(fi os.FileInfo) bool {
+		if !includeTests && strings.HasSuffix(fi.Name(), "_test.go") {
+			return false
+		}
+		return !containsString(exclude, filepath.Join(dir, fi.Name()))
+	}
+
+	pkgs, _ := parser.ParseDir(fset, dir, filter, 0)
+
+	pkg, ok := pkgs[pkgName]
+	if !ok {
+		return nil
+	}
+
+	var files []*ast.File
+	for _, file := range pkg.Files {
+		files = append(files, file)
+	}
+	return files
+}
+
+// typeInfo is what the generator needs to know about a type.
+type typeInfo struct {
+	// Comparable is true if the type (or, for pointers, the type pointed
+	// to) supports ==.
+	Comparable bool
+
+	// EqualMethod is true if the type is not Comparable, but has an
+	// Equal(T) bool method to use instead.
+	EqualMethod bool
+
+	// Ordered is true if the type (or, for pointers, the type pointed to)
+	// supports <.
+	Ordered bool
+
+	// LessMethod is true if the type is not Ordered, but has a Less(T) bool
+	// method to use instead.
+	LessMethod bool
+
+	// CompareMethod is true if the type is not Ordered and has no
+	// LessMethod, but has a Compare(T) int method to use instead.
+	CompareMethod bool
+
+	// Numeric is true if the type is an integer or float, so supports
+	// arithmetic and conversion to float64.
+	Numeric bool
+
+	// Integer and Unsigned are true for integer and unsigned integer
+	// Numeric types.
+	Integer  bool
+	Unsigned bool
+
+	// SliceDepth is how many levels of slices the type is made of: 1 if its
+	// underlying type is []int, 2 for [][]int or []Row where Row is []int,
+	// and so on.
+	SliceDepth int
+
+	// SliceElem is the literal for the innermost element type of a type with
+	// a SliceDepth, such as int in each of the examples above. If it cannot
+	// be written in the generated file, SliceDepth is 0.
+	SliceElem string
+}
+
+// newTypeInfo finds out about t. Types from packages local reports false for
+// cannot be written in the generated file.
+func newTypeInfo(t types.Type, local func(*types.Package) bool) typeInfo {
+	elem := t
+	if ptr, ok := t.(*types.Pointer); ok {
+		elem = ptr.Elem()
+	}
+
+	info := typeInfo{
+		Comparable: types.Comparable(elem),
+	}
+	if !info.Comparable {
+		info.EqualMethod = hasMethod(elem, "Equal", []types.Type{elem}, []types.Type{types.Typ[types.Bool]})
+	}
+
+	if basic, ok := elem.Underlying().(*types.Basic); ok {
+		info.Ordered = basic.Info()&types.IsOrdered != 0
+		info.Numeric = basic.Info()&(types.IsInteger|types.IsFloat) != 0
+		info.Integer = basic.Info()&types.IsInteger != 0
+		info.Unsigned = basic.Info()&types.IsUnsigned != 0
+	}
+	if !info.Ordered {
+		info.LessMethod = hasMethod(elem, "Less", []types.Type{elem}, []types.Type{types.Typ[types.Bool]})
+	}
+	if !info.Ordered && !info.LessMethod {
+		info.CompareMethod = hasMethod(elem, "Compare", []types.Type{elem}, []types.Type{types.Typ[types.Int]})
+	}
+
+	inner := t
+	for {
+		slice, ok := inner.Underlying().(*types.Slice)
+		if !ok {
+			break
+		}
+		info.SliceDepth++
+		inner = slice.Elem()
+	}
+	if info.SliceDepth > 0 {
+		writable := true
+		info.SliceElem = types.TypeString(inner, func(pkg *types.Package) string {
+			if local(pkg) {
+				return ""
+			}
+			writable = false
+			return pkg.Name()
+		})
+		if !writable {
+			info.SliceDepth, info.SliceElem = 0, ""
+		}
+	}
+
+	return info
+}
+
+// hasMethod reports whether t (or *t) has the named method with the given
+// parameter and result types.
+func hasMethod(t types.Type, name string, params []types.Type, results []types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, name)
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != len(params) || sig.Results().Len() != len(results) || sig.Variadic() {
+		return false
+	}
+	for i, p := range params {
+		if !types.Identical(sig.Params().At(i).Type(), p) {
+			return false
+		}
+	}
+	for i, r := range results {
+		if !types.Identical(sig.Results().At(i).Type(), r) {
+			return false
+		}
+	}
+	return true
+}
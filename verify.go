@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+)
+
+// maxVerifyErrors limits how many type errors are reported for a generated
+// file.
+const maxVerifyErrors = 10
+
+// generatedFile is the formatted content of an outputFile.
+type generatedFile struct {
+	file    *outputFile
+	content []byte
+}
+
+// formatFile gofmts generated content.
+func formatFile(f *outputFile, content []byte) ([]byte, error) {
+	formatted, err := format.Source(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: generated code does not parse: %s", f.Path, err)
+	}
+	return formatted, nil
+}
+
+// verifyFiles type-checks each generated file within the package it is
+// generated into, as it will be once all of generated have been written.
+func verifyFiles(generated []generatedFile) error {
+	for _, g := range generated {
+		err := verifyFile(g, generated)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyFile(g generatedFile, generated []generatedFile) error {
+	fset := token.NewFileSet()
+	dir := filepath.Dir(g.file.Path)
+	filename := filepath.Join(dir, filepath.Base(g.file.Path))
+	isTest := strings.HasSuffix(filename, "_test.go")
+
+	// files generated alongside this one replace those on disk
+	var exclude []string
+	var files []*ast.File
+	for _, other := range generated {
+		otherFilename := filepath.Join(filepath.Dir(other.file.Path), filepath.Base(other.file.Path))
+		if filepath.Dir(otherFilename) != dir {
+			continue
+		}
+		exclude = append(exclude, otherFilename)
+
+		if other.file.Package != g.file.Package || (!isTest && strings.HasSuffix(otherFilename, "_test.go")) {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, otherFilename, other.content, 0)
+		if err != nil {
+			return fmt.Errorf("%s: %s", other.file.Path, err)
+		}
+		files = append(files, f)
+	}
+	files = append(files, packageFiles(fset, dir, g.file.Package, isTest, exclude)...)
+
+	// only errors in the generated file are of interest, as the rest of the
+	// package may depend on code not yet generated
+	var errs []string
+	var last error
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			terr, ok := err.(types.Error)
+			if ok && terr.Fset.Position(terr.Pos).Filename == filename {
+				// details of an error elsewhere which involves this file,
+				// such as a redeclaration, need the error they belong to
+				if strings.HasPrefix(terr.Msg, "\t") && last != nil && !strings.HasPrefix(last.Error(), filename+":") {
+					errs = append(errs, last.Error())
+				}
+				errs = append(errs, terr.Error())
+			}
+			last = err
+		},
+	}
+	conf.Check(g.file.Package, fset, files, nil)
+
+	if len(errs) > 0 {
+		if len(errs) > maxVerifyErrors {
+			errs = append(errs[:maxVerifyErrors], "too many errors")
+		}
+		return fmt.Errorf("%s: generated code does not compile:\n\t%s", g.file.Path, strings.Join(errs, "\n\t"))
+	}
+
+	return nil
+}